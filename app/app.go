@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
@@ -65,6 +66,7 @@ var (
 		distr.ModuleName:          nil,
 		staking.BondedPoolName:    {supply.Burner, supply.Staking},
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		nameservice.ModuleName:    nil,
 	}
 )
 
@@ -132,7 +134,7 @@ func NewInitApp(
 
 	// TODO: Add the keys that module requires
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
-		supply.StoreKey, distr.StoreKey, slashing.StoreKey, params.StoreKey)
+		supply.StoreKey, distr.StoreKey, slashing.StoreKey, params.StoreKey, nameservice.StoreKey)
 
 	tKeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
@@ -154,6 +156,7 @@ func NewInitApp(
 	app.subspaces[staking.ModuleName] = app.paramsKeeper.Subspace(staking.DefaultParamspace)
 	app.subspaces[distr.ModuleName] = app.paramsKeeper.Subspace(distr.DefaultParamspace)
 	app.subspaces[slashing.ModuleName] = app.paramsKeeper.Subspace(slashing.DefaultParamspace)
+	app.subspaces[nameservice.ModuleName] = app.paramsKeeper.Subspace(nameservice.DefaultParamspace)
 
 	// The AccountKeeper handles address -> account lookups
 	app.accountKeeper = auth.NewAccountKeeper(
@@ -215,9 +218,13 @@ func NewInitApp(
 	// Initialize Added Keepers
 
 	app.nsKeeper = nameservice.NewKeeper(
+		app.accountKeeper,
 		app.bankKeeper,
+		app.supplyKeeper,
+		app.distrKeeper,
 		keys[nameservice.StoreKey],
 		app.cdc,
+		app.subspaces[nameservice.ModuleName],
 	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -240,13 +247,14 @@ func NewInitApp(
 	// CanWithdrawInvariant invariant.
 
 	app.mm.SetOrderBeginBlockers(distr.ModuleName, slashing.ModuleName)
-	app.mm.SetOrderEndBlockers(staking.ModuleName)
+	app.mm.SetOrderEndBlockers(staking.ModuleName, nameservice.ModuleName)
 
 	// Sets the order of Genesis - Order matters, genutil is to always come last
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
+	// NOTE: Genesis accounts are part of auth's genesis, which must occur
+	// before nameservice so that genesis names are owned by existing accounts.
 	app.mm.SetOrderInitGenesis(
-		genaccounts.ModuleName,
 		distr.ModuleName,
 		staking.ModuleName,
 		auth.ModuleName,
@@ -267,14 +275,23 @@ func NewInitApp(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
-	// The AnteHandler handles signature verification and transaction pre-processing
-	app.SetAnteHandler(
-		auth.NewAnteHandler(
-			app.accountKeeper,
-			app.supplyKeeper,
-			auth.DefaultSigVerificationGasConsumer,
-		),
-	)
+	// The AnteHandler handles signature verification and transaction pre-processing. It is
+	// auth's, with fee allowances paying for nameservice txs just before their fees are
+	// deducted, once the gas meter is set up so looking allowances up is charged for
+	app.SetAnteHandler(sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(),
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewValidateMemoDecorator(app.accountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(app.accountKeeper),
+		ante.NewSetPubKeyDecorator(app.accountKeeper),
+		ante.NewValidateSigCountDecorator(app.accountKeeper),
+		nameservice.NewFeeAllowanceDecorator(app.nsKeeper),
+		ante.NewDeductFeeDecorator(app.accountKeeper, app.supplyKeeper),
+		ante.NewSigGasConsumeDecorator(app.accountKeeper, auth.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(app.accountKeeper),
+		ante.NewIncrementSequenceDecorator(app.accountKeeper),
+	))
 
 	// initialize stores
 	app.MountKVStores(keys)
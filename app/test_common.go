@@ -0,0 +1,283 @@
+package app
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/p2p"
+	"github.com/tendermint/tendermint/privval"
+	"github.com/tendermint/tendermint/proxy"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+)
+
+// TestNodeCoins is what the test node's one account starts with, besides
+// what it bonds to its validator
+var TestNodeCoins = sdk.NewCoins(sdk.NewInt64Coin("nametoken", 1000000))
+
+// TestNode runs the app behind an in-process tendermint node, for testing
+// clients against a chain that makes blocks and emits events. Its one
+// validator is bonded by Account, the only account at genesis.
+type TestNode struct {
+	Client  rpcclient.Client
+	Cdc     *codec.Codec
+	ChainID string
+	Account sdk.AccAddress
+
+	// RPCAddress Serves Commands That Dial The Node Themselves
+	RPCAddress string
+
+	node *node.Node
+	key  crypto.PrivKey
+	home string
+
+	// Deliveries Are Serialized, Each Signed At The Sequence The Last Left
+	mtx sync.Mutex
+}
+
+// StartTestNode starts a node whose nameservice genesis is nsGenesis, and
+// returns once it has committed its first block
+func StartTestNode(nsGenesis nameservice.GenesisState) (*TestNode, error) {
+	home, err := ioutil.TempDir("", "nameservice-testnode")
+	if err != nil {
+		return nil, err
+	}
+
+	n := &TestNode{
+		Cdc:     MakeCodec(),
+		ChainID: "nameservice-test",
+		key:     secp256k1.GenPrivKey(),
+		home:    home,
+	}
+	n.Account = sdk.AccAddress(n.key.PubKey().Address())
+
+	if err := n.start(nsGenesis); err != nil {
+		os.RemoveAll(home)
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// Stop stops the node and removes its files
+func (n *TestNode) Stop() {
+	n.node.Stop()
+	n.node.Wait()
+	os.RemoveAll(n.home)
+}
+
+// CLIContext returns a context querying and broadcasting through the node
+func (n *TestNode) CLIContext() context.CLIContext {
+	return context.CLIContext{}.
+		WithCodec(n.Cdc).
+		WithClient(n.Client).
+		WithChainID(n.ChainID).
+		WithTrustNode(true).
+		WithFromAddress(n.Account).
+		WithBroadcastMode("block")
+}
+
+// Tx returns msgs in a tx signed by Account at its current sequence, for
+// broadcasting by the client under test
+func (n *TestNode) Tx(msgs ...sdk.Msg) ([]byte, error) {
+	accNum, seq, err := auth.NewAccountRetriever(n.CLIContext()).GetAccountNumberSequence(n.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := n.sign(accNum, seq, msgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return auth.DefaultTxEncoder(n.Cdc)(tx)
+}
+
+// Deliver signs msgs as Account and broadcasts them, returning once they
+// are committed. A tx failing its checks or its execution is an error.
+func (n *TestNode) Deliver(msgs ...sdk.Msg) error {
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+
+	bz, err := n.Tx(msgs...)
+	if err != nil {
+		return err
+	}
+
+	res, err := n.Client.BroadcastTxCommit(bz)
+
+	// Error Occurred
+	if err != nil {
+		return err
+	}
+	if res.CheckTx.IsErr() {
+		return fmt.Errorf("tx failed its checks: %s", res.CheckTx.Log)
+	}
+	if res.DeliverTx.IsErr() {
+		return fmt.Errorf("tx failed: %s", res.DeliverTx.Log)
+	}
+
+	return nil
+}
+
+// WaitForHeight blocks until the node has committed height
+func (n *TestNode) WaitForHeight(height int64) error {
+	for i := 0; i < 1000; i++ {
+		status, err := n.Client.Status()
+		if err != nil {
+			return err
+		}
+		if status.SyncInfo.LatestBlockHeight >= height {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return fmt.Errorf("timed out waiting for height %d", height)
+}
+
+func (n *TestNode) start(nsGenesis nameservice.GenesisState) error {
+	config := cfg.TestConfig().SetRoot(n.home)
+	cfg.EnsureRoot(n.home)
+
+	p2pAddr, err := freeAddress()
+	if err != nil {
+		return err
+	}
+	if n.RPCAddress, err = freeAddress(); err != nil {
+		return err
+	}
+
+	// Clients Reach The Node In Process, Through Its Local Client
+	config.P2P.ListenAddress = p2pAddr
+	config.P2P.PexReactor = false
+	config.RPC.ListenAddress = n.RPCAddress
+	config.RPC.GRPCListenAddress = ""
+	config.TxIndex.IndexAllKeys = true
+
+	pv := privval.GenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+	pv.Save()
+
+	nodeKey, err := p2p.LoadOrGenNodeKey(config.NodeKeyFile())
+	if err != nil {
+		return err
+	}
+
+	genDoc, err := n.genesis(pv.GetPubKey(), nsGenesis)
+	if err != nil {
+		return err
+	}
+
+	app := NewInitApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+
+	n.node, err = node.NewNode(config, pv, nodeKey, proxy.NewLocalClientCreator(app),
+		func() (*tmtypes.GenesisDoc, error) { return genDoc, nil },
+		func(*node.DBContext) (dbm.DB, error) { return dbm.NewMemDB(), nil },
+		node.DefaultMetricsProvider(config.Instrumentation),
+		log.NewNopLogger(),
+	)
+
+	// Error Occurred
+	if err != nil {
+		return err
+	}
+
+	if err := n.node.Start(); err != nil {
+		return err
+	}
+	n.Client = rpcclient.NewLocal(n.node)
+
+	if err := n.WaitForHeight(1); err != nil {
+		n.node.Stop()
+		n.node.Wait()
+		return err
+	}
+
+	return nil
+}
+
+// genesis funds Account and has it bond valPubKey's validator through a
+// gentx, as a real chain's genesis would
+func (n *TestNode) genesis(valPubKey crypto.PubKey, nsGenesis nameservice.GenesisState) (*tmtypes.GenesisDoc, error) {
+	bond := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1))
+
+	account := auth.NewBaseAccountWithAddress(n.Account)
+	if err := account.SetCoins(TestNodeCoins.Add(bond)); err != nil {
+		return nil, err
+	}
+
+	createValidator := staking.NewMsgCreateValidator(
+		sdk.ValAddress(n.Account),
+		valPubKey,
+		bond,
+		staking.NewDescription("test", "", "", "", ""),
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.OneInt(),
+	)
+
+	// Gentxs Are Signed At Account Number And Sequence Zero
+	genTx, err := n.sign(0, 0, createValidator)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := NewDefaultGenesisState()
+	genState[auth.ModuleName] = n.Cdc.MustMarshalJSON(auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{&account}))
+	genState[genutil.ModuleName] = n.Cdc.MustMarshalJSON(genutil.NewGenesisStateFromStdTx([]auth.StdTx{genTx}))
+	genState[nameservice.ModuleName] = n.Cdc.MustMarshalJSON(nsGenesis)
+
+	appState, err := codec.MarshalJSONIndent(n.Cdc, genState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmtypes.GenesisDoc{
+		ChainID:         n.ChainID,
+		GenesisTime:     tmtime.Now(),
+		ConsensusParams: tmtypes.DefaultConsensusParams(),
+		AppState:        appState,
+	}, nil
+}
+
+func (n *TestNode) sign(accNum, seq uint64, msgs ...sdk.Msg) (auth.StdTx, error) {
+	fee := auth.NewStdFee(200000, nil)
+
+	sig, err := n.key.Sign(auth.StdSignBytes(n.ChainID, accNum, seq, fee, msgs, ""))
+	if err != nil {
+		return auth.StdTx{}, err
+	}
+
+	return auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: n.key.PubKey(), Signature: sig}}, ""), nil
+}
+
+// freeAddress returns a local address nothing is listening on
+func freeAddress() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+
+	return "tcp://" + l.Addr().String(), nil
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"os"
 	"path"
 
@@ -29,6 +28,12 @@ import (
 	"github.com/tendermint/tendermint/libs/cli"
 
 	app "github.com/arjunandra/nameservice-cosmos/app"
+	nscli "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/cli"
+	nsdns "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+	nsgateway "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/gateway"
+	nsgrpc "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/grpc"
+	nsindexer "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/indexer"
+	nsrest "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/rest"
 
 )
 
@@ -57,8 +62,13 @@ func main() {
 
 	// Add --chain-id to persistent flags and mark it required
 	rootCmd.PersistentFlags().String(flags.FlagChainID, "", "Chain ID of tendermint node")
-	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
-		return initConfig(rootCmd)
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := initConfig(rootCmd); err != nil {
+			return err
+		}
+
+		// Let Everyday Commands Take name.ns Wherever They Take An Address
+		return resolveNameArgs(cdc, cmd, args)
 	}
 
 	// Construct Root Command
@@ -68,7 +78,9 @@ func main() {
 		queryCmd(cdc),
 		txCmd(cdc),
 		flags.LineBreak,
-		lcd.ServeCommand(cdc, registerRoutes),
+		nsrest.RegisterServerFlags(lcd.ServeCommand(cdc, registerRoutes)),
+		nsdns.ServeCommand(cdc),
+		nameserviceCmd(cdc),
 		flags.LineBreak,
 		keys.Commands(),
 		flags.LineBreak,
@@ -79,10 +91,15 @@ func main() {
 	// Add flags and prefix all env exposed with AA
 	executor := cli.PrepareMainCmd(rootCmd, "AA", app.DefaultCLIHome)
 
-	err := executor.Execute()
-	if err != nil {
-		fmt.Printf("Failed executing CLI command: %s, exiting...\n", err)
-		os.Exit(1)
+	// --output Takes More Formats Than PrepareMainCmd Allows, Which Are Checked Per Command
+	nscli.ExtendOutputFormats(rootCmd)
+
+	// Errors Are Printed Here, So They Can Be Structured And Set The Exit Code
+	executor.SilenceUsage = true
+	executor.SilenceErrors = true
+	if err := executor.Command.Execute(); err != nil {
+		nscli.PrintError(os.Stderr, err)
+		os.Exit(nscli.ExitCode(err))
 	}
 }
 
@@ -144,6 +161,22 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 	return txCmd
 }
 
+// nameserviceCmd groups the long-running nameservice services that aren't
+// queries or txs
+func nameserviceCmd(cdc *amino.Codec) *cobra.Command {
+	nameserviceCmd := &cobra.Command{
+		Use:   "nameservice",
+		Short: "Nameservice services",
+	}
+
+	nameserviceCmd.AddCommand(
+		nsindexer.IndexerCommand(cdc),
+		nsgrpc.ServeCommand(cdc),
+	)
+
+	return nameserviceCmd
+}
+
 // registerRoutes registers the routes from the different modules for the LCD.
 // NOTE: details on the routes added for each module are in the module documentation
 // NOTE: If making updates here you also need to update the test helper in client/lcd/test_helper.go
@@ -151,6 +184,7 @@ func registerRoutes(rs *lcd.RestServer) {
 	client.RegisterRoutes(rs.CliCtx, rs.Mux)
 	authrest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
 	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
+	nsgateway.RegisterRoutes(rs.CliCtx, rs.Mux)
 }
 
 func initConfig(cmd *cobra.Command) error {
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	nsdns "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+)
+
+// Annotation Marking A String Flag As Taking An Account Address, e.g.
+// cmd.Flags().SetAnnotation("recipient", addressAnnotation, nil)
+const addressAnnotation = "nameservice_address"
+
+// resolveNameArgs replaces the arguments and flags of a query or tx command
+// that take an account address and are given a name under the nameservice
+// zone (e.g. alice.ns) with the address it resolves to. Arguments take an
+// address when their placeholder in the command's usage says so (e.g.
+// [to_address]), flags when annotated with addressAnnotation. The nameservice
+// commands themselves take names and are left alone.
+func resolveNameArgs(cdc *codec.Codec, cmd *cobra.Command, args []string) error {
+	if !resolvesNames(cmd) {
+		return nil
+	}
+
+	var cliCtx *context.CLIContext
+	resolve := func(arg string) (string, error) {
+		name, ok := nameArg(arg)
+		if !ok {
+			return arg, nil
+		}
+
+		// The Context Reads Its Flags, So Only Build It Once A Name Turns Up
+		if cliCtx == nil {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			cliCtx = &ctx
+		}
+
+		route := fmt.Sprintf("custom/%s/address/%s", nameservice.ModuleName, name)
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve %s: %w", arg, err)
+		}
+
+		var out nameservice.QueryResAddress
+		if err := cdc.UnmarshalJSON(res, &out); err != nil {
+			return "", fmt.Errorf("failed to resolve %s: %w", arg, err)
+		}
+
+		fmt.Fprintf(cmd.ErrOrStderr(), "Resolved %s to %s\n", arg, out.Address)
+		return out.Address.String(), nil
+	}
+
+	// Cobra Hands The Same Slice To RunE, So Args Are Replaced In Place
+	placeholders := argPlaceholders(cmd)
+	for i, arg := range args {
+		if i >= len(placeholders) || !isAddressPlaceholder(placeholders[i]) {
+			continue
+		}
+
+		resolved, err := resolve(arg)
+		if err != nil {
+			return err
+		}
+		args[i] = resolved
+	}
+
+	var err error
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if _, ok := flag.Annotations[addressAnnotation]; err != nil || !ok || flag.Value.Type() != "string" {
+			return
+		}
+
+		var resolved string
+		if resolved, err = resolve(flag.Value.String()); err == nil && resolved != flag.Value.String() {
+			err = flag.Value.Set(resolved)
+		}
+	})
+
+	return err
+}
+
+// resolvesNames reports whether cmd sits under the query or tx commands and
+// outside the nameservice module's own commands
+func resolvesNames(cmd *cobra.Command) bool {
+	top := cmd
+	for ; top.HasParent() && top.Parent().HasParent(); top = top.Parent() {
+		if top.Name() == nameservice.ModuleName {
+			return false
+		}
+	}
+
+	return top.Name() == "query" || top.Name() == "tx"
+}
+
+// argPlaceholders returns the positional arguments named in cmd's usage line,
+// e.g. from_key_or_address, to_address and amount for
+// "send [from_key_or_address] [to_address] [amount]"
+func argPlaceholders(cmd *cobra.Command) []string {
+	fields := strings.Fields(cmd.Use)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(fields)-1)
+	for _, field := range fields[1:] {
+		placeholders = append(placeholders, strings.Trim(field, "[]<>."))
+	}
+	return placeholders
+}
+
+// isAddressPlaceholder reports whether an argument named placeholder takes an
+// account address. Signers (from_key_or_address) are keyring entries, and
+// validator addresses aren't what names resolve to.
+func isAddressPlaceholder(placeholder string) bool {
+	placeholder = strings.ToLower(placeholder)
+	if strings.HasPrefix(placeholder, "from") || strings.Contains(placeholder, "validator") {
+		return false
+	}
+
+	for _, suffix := range []string{"address", "addr"} {
+		if placeholder == suffix || strings.HasSuffix(placeholder, "_"+suffix) || strings.HasSuffix(placeholder, "-"+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// nameArg returns the name arg refers to when it is a valid name under the
+// nameservice zone
+func nameArg(arg string) (string, bool) {
+	suffix := "." + nsdns.DefaultZone
+	if !strings.HasSuffix(arg, suffix) {
+		return "", false
+	}
+
+	name := strings.TrimSuffix(arg, suffix)
+	if nameservice.ValidateName(name) != nil {
+		return "", false
+	}
+
+	return name, true
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	app "github.com/arjunandra/nameservice-cosmos/app"
+)
+
+func TestIsAddressPlaceholder(t *testing.T) {
+	tests := map[string]bool{
+		"address":             true,
+		"to_address":          true,
+		"delegator-addr":      true,
+		"withdraw-addr":       true,
+		"from_key_or_address": false,
+		"validator-addr":      false,
+		"src-validator-addr":  false,
+		"amount":              false,
+		"name":                false,
+		"memo_address_book":   false,
+	}
+
+	for placeholder, want := range tests {
+		if got := isAddressPlaceholder(placeholder); got != want {
+			t.Errorf("isAddressPlaceholder(%q) = %v, want %v", placeholder, got, want)
+		}
+	}
+}
+
+func TestArgPlaceholders(t *testing.T) {
+	cmd := &cobra.Command{Use: "rewards [delegator-addr] [<validator-addr>] [coins...]"}
+
+	got := argPlaceholders(cmd)
+	want := []string{"delegator-addr", "validator-addr", "coins"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+// Names Outside Address Arguments And Flags Are Left Alone, Without Querying A Node
+func TestResolveNameArgsSkipsOtherArgs(t *testing.T) {
+	tx := &cobra.Command{Use: "tx"}
+	root := &cobra.Command{Use: "acli"}
+	root.AddCommand(tx)
+
+	send := &cobra.Command{Use: "send [from_key_or_address] [amount] [name]"}
+	send.Flags().String("memo", "", "")
+	send.Flags().String("chain-id", "", "")
+	tx.AddCommand(send)
+
+	if err := send.Flags().Parse([]string{"--memo", "paid.ns", "--chain-id", "test.ns"}); err != nil {
+		t.Fatal(err)
+	}
+
+	args := []string{"alice.ns", "10nametoken", "bob.ns"}
+	if err := resolveNameArgs(app.MakeCodec(), send, args); err != nil {
+		t.Fatal(err)
+	}
+
+	if args[0] != "alice.ns" || args[2] != "bob.ns" {
+		t.Errorf("args rewritten to %v", args)
+	}
+	for flag, want := range map[string]string{"memo": "paid.ns", "chain-id": "test.ns"} {
+		if got, _ := send.Flags().GetString(flag); got != want {
+			t.Errorf("--%s rewritten to %s", flag, got)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+)
+
+// genesisName is a single entry of a bulk names file
+type genesisName struct {
+	Name  string `json:"name"`
+	Owner string `json:"owner"`
+	Value string `json:"value"`
+	Price string `json:"price"`
+}
+
+// AddGenesisNameCmd returns add-genesis-name cobra Command.
+func AddGenesisNameCmd(
+	ctx *server.Context, cdc *codec.Codec, defaultNodeHome, defaultClientHome string,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "add-genesis-name [name] [owner_address_or_key_name] [value] [price]",
+		Short: "Add a name owned by a genesis account to genesis.json",
+		Long: `Add a name to the nameservice section of genesis.json. The name must follow the
+module's naming rules and the owner must already be a genesis account (see
+add-genesis-account). If a key name is given, the address will be looked up in the
+local Keybase.
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			record, err := newGenesisWhoIs(
+				genesisName{Name: args[0], Owner: args[1], Value: args[2], Price: args[3]},
+				bufio.NewReader(cmd.InOrStdin()),
+			)
+			if err != nil {
+				return err
+			}
+
+			return addGenesisNames(cdc, config.GenesisFile(), []nameservice.GenesisWhoIs{record})
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+
+	return cmd
+}
+
+// AddGenesisNamesCmd returns add-genesis-names cobra Command.
+func AddGenesisNamesCmd(
+	ctx *server.Context, cdc *codec.Codec, defaultNodeHome, defaultClientHome string,
+) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "add-genesis-names [file]",
+		Short: "Add the names listed in a CSV or JSON file to genesis.json",
+		Long: `Add many names to the nameservice section of genesis.json at once. A .json file
+holds an array of {"name", "owner", "value", "price"} objects; any other file is read
+as CSV with the columns name,owner,value,price (a header row is optional). Every entry
+is checked as by add-genesis-name, and nothing is written unless all of them pass.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			var entries []genesisName
+			if strings.EqualFold(filepath.Ext(args[0]), ".json") {
+				err = json.NewDecoder(file).Decode(&entries)
+			} else {
+				entries, err = readGenesisNamesCSV(file)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to parse %s: %w", args[0], err)
+			}
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+
+			records := make([]nameservice.GenesisWhoIs, 0, len(entries))
+			for _, entry := range entries {
+				record, err := newGenesisWhoIs(entry, inBuf)
+				if err != nil {
+					return err
+				}
+				records = append(records, record)
+			}
+
+			return addGenesisNames(cdc, config.GenesisFile(), records)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
+
+	return cmd
+}
+
+func readGenesisNamesCSV(r io.Reader) ([]genesisName, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]genesisName, 0, len(rows))
+	for i, row := range rows {
+		if i == 0 && strings.EqualFold(row[0], "name") {
+			continue
+		}
+		entries = append(entries, genesisName{Name: row[0], Owner: row[1], Value: row[2], Price: row[3]})
+	}
+
+	return entries, nil
+}
+
+// newGenesisWhoIs builds the genesis record for an entry, resolving its owner
+// and checking it against the module's rules
+func newGenesisWhoIs(entry genesisName, inBuf *bufio.Reader) (nameservice.GenesisWhoIs, error) {
+	if err := nameservice.ValidateName(entry.Name); err != nil {
+		return nameservice.GenesisWhoIs{}, err
+	}
+
+	owner, err := addressFromArg(entry.Owner, inBuf)
+	if err != nil {
+		return nameservice.GenesisWhoIs{}, fmt.Errorf("invalid owner for %s: %w", entry.Name, err)
+	}
+
+	price, err := sdk.ParseCoins(entry.Price)
+	if err != nil {
+		return nameservice.GenesisWhoIs{}, fmt.Errorf("failed to parse price for %s: %w", entry.Name, err)
+	}
+
+	if !price.IsAllPositive() {
+		return nameservice.GenesisWhoIs{}, fmt.Errorf("price for %s must be positive", entry.Name)
+	}
+
+	whois := nameservice.NewWhoIs()
+	whois.Owner = owner
+	whois.Value = entry.Value
+	whois.Price = price
+
+	return nameservice.GenesisWhoIs{Name: entry.Name, WhoIs: whois}, nil
+}
+
+// addressFromArg parses a bech32 address, falling back to looking it up as a
+// key name in the local Keybase
+func addressFromArg(arg string, inBuf *bufio.Reader) (sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(arg)
+	if err == nil {
+		return addr, nil
+	}
+
+	kb, err := keys.NewKeyring(
+		sdk.KeyringServiceName(),
+		viper.GetString(flags.FlagKeyringBackend),
+		viper.GetString(flagClientHome),
+		inBuf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := kb.Get(arg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get address from Keybase: %w", err)
+	}
+
+	return info.GetAddress(), nil
+}
+
+// addGenesisNames appends records to the nameservice genesis state, after
+// checking their owners are genesis accounts and their names are still free
+func addGenesisNames(cdc *codec.Codec, genFile string, records []nameservice.GenesisWhoIs) error {
+	appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	authGenState := auth.GetGenesisStateFromAppState(cdc, appState)
+
+	var nsGenState nameservice.GenesisState
+	if err := cdc.UnmarshalJSON(appState[nameservice.ModuleName], &nsGenState); err != nil {
+		return fmt.Errorf("failed to unmarshal nameservice genesis state: %w", err)
+	}
+
+	for _, record := range records {
+		if !authGenState.Accounts.Contains(record.WhoIs.Owner) {
+			return fmt.Errorf("owner %s of %s is not a genesis account", record.WhoIs.Owner, record.Name)
+		}
+	}
+
+	// Duplicates, Against The File Or Within The Batch, Are Caught Here
+	nsGenState.WhoIsRecords = append(nsGenState.WhoIsRecords, records...)
+	if err := nameservice.ValidateGenesis(nsGenState); err != nil {
+		return fmt.Errorf("failed to validate nameservice genesis state: %w", err)
+	}
+
+	nsGenStateBz, err := cdc.MarshalJSON(nsGenState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal nameservice genesis state: %w", err)
+	}
+
+	appState[nameservice.ModuleName] = nsGenStateBz
+
+	appStateJSON, err := cdc.MarshalJSON(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+
+	genDoc.AppState = appStateJSON
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
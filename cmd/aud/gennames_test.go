@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+)
+
+// newTestHome writes a default genesis into a temporary node home, which the
+// genesis commands are pointed at
+func newTestHome(t *testing.T, cdc *codec.Codec) *server.Context {
+	t.Helper()
+
+	home, err := ioutil.TempDir("", "nameservice-aud")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(home) })
+
+	ctx := server.NewDefaultContext()
+	ctx.Config.SetRoot(home)
+	if err := os.MkdirAll(filepath.Dir(ctx.Config.GenesisFile()), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	genDoc := &tmtypes.GenesisDoc{
+		ChainID:  "nameservice-test",
+		AppState: codec.MustMarshalJSONIndent(cdc, app.NewDefaultGenesisState()),
+	}
+	if err := genutil.ExportGenesisFile(genDoc, ctx.Config.GenesisFile()); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Set(cli.HomeFlag, home)
+	t.Cleanup(viper.Reset)
+
+	return ctx
+}
+
+// run runs cmd's RunE with args, as the command line would
+func run(cmd *cobra.Command, args ...string) error {
+	return cmd.RunE(cmd, args)
+}
+
+// readGenesis returns the nameservice genesis state in genFile
+func readGenesis(t *testing.T, cdc *codec.Codec, genFile string) nameservice.GenesisState {
+	t.Helper()
+
+	appState, _, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var genState nameservice.GenesisState
+	cdc.MustUnmarshalJSON(appState[nameservice.ModuleName], &genState)
+	return genState
+}
+
+// initChain starts a fresh app from genFile and commits its genesis
+func initChain(t *testing.T, cdc *codec.Codec, genFile string) *app.NewApp {
+	t.Helper()
+
+	_, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nsApp := app.NewInitApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, 0)
+	nsApp.InitChain(abci.RequestInitChain{ChainId: genDoc.ChainID, AppStateBytes: genDoc.AppState})
+	nsApp.Commit()
+
+	return nsApp
+}
+
+// queryApp runs a custom query against the app's committed state
+func queryApp(t *testing.T, nsApp *app.NewApp, path string, data []byte) abci.ResponseQuery {
+	t.Helper()
+
+	return nsApp.Query(abci.RequestQuery{Path: "custom/" + path, Data: data})
+}
+
+func TestAddGenesisNames(t *testing.T) {
+	cdc := app.MakeCodec()
+	ctx := newTestHome(t, cdc)
+	genFile := ctx.Config.GenesisFile()
+
+	alice := sdk.AccAddress("alice-genesis-owner-")
+	bob := sdk.AccAddress("bob-genesis-owner---")
+	stranger := sdk.AccAddress("not-a-genesis-acct--")
+
+	addAccount := AddGenesisAccountCmd(ctx, cdc, "", "")
+	addName := AddGenesisNameCmd(ctx, cdc, "", "")
+	addNames := AddGenesisNamesCmd(ctx, cdc, "", "")
+
+	if err := run(addAccount, alice.String(), "100nametoken"); err != nil {
+		t.Fatal(err)
+	}
+	if err := run(addAccount, bob.String(), "50nametoken"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := run(addName, "alice", alice.String(), "10.0.0.1", "5nametoken"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Rejected Names Leave The File As It Was
+	for _, args := range [][]string{
+		{"Not A Name", alice.String(), "10.0.0.1", "5nametoken"},
+		{"carol", stranger.String(), "10.0.0.1", "5nametoken"},
+		{"carol", alice.String(), "10.0.0.1", "0nametoken"},
+		{"alice", bob.String(), "10.0.0.2", "5nametoken"},
+	} {
+		if err := run(addName, args...); err == nil {
+			t.Errorf("add-genesis-name %v was accepted", args)
+		}
+	}
+	if got := readGenesis(t, cdc, genFile).WhoIsRecords; len(got) != 1 {
+		t.Fatalf("genesis holds %d names after rejected additions, want 1", len(got))
+	}
+
+	dir := filepath.Dir(genFile)
+	write := func(name, contents string) string {
+		path := filepath.Join(dir, name)
+		if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	csvFile := write("names.csv", fmt.Sprintf("name,owner,value,price\n# bob's names\nbob,%s,10.0.0.2,7nametoken\nbobby,%s,10.0.0.3,8nametoken\n", bob, bob))
+	jsonFile := write("names.json", fmt.Sprintf(`[{"name": "dave", "owner": %q, "value": "10.0.0.4", "price": "9nametoken"}]`, alice.String()))
+
+	// A Batch With One Bad Entry Adds None Of Them
+	badFile := write("bad.csv", fmt.Sprintf("erin,%s,10.0.0.5,1nametoken\nbob,%s,10.0.0.6,1nametoken\n", alice, alice))
+
+	if err := run(addNames, csvFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := run(addNames, jsonFile); err != nil {
+		t.Fatal(err)
+	}
+	if err := run(addNames, badFile); err == nil || !strings.Contains(err.Error(), "bob") {
+		t.Errorf("got %v adding a batch repeating bob, want it refused", err)
+	}
+
+	genState := readGenesis(t, cdc, genFile)
+	if err := nameservice.ValidateGenesis(genState); err != nil {
+		t.Fatal(err)
+	}
+	if len(genState.WhoIsRecords) != 4 {
+		t.Fatalf("genesis holds %+v, want alice, bob, bobby and dave", genState.WhoIsRecords)
+	}
+
+	// The Chain Starts With The Accounts And The Names They Own
+	nsApp := initChain(t, cdc, genFile)
+
+	for name, want := range map[string]sdk.AccAddress{"alice": alice, "bob": bob, "bobby": bob, "dave": alice} {
+		res := queryApp(t, nsApp, nameservice.StoreKey+"/whois/"+name, nil)
+		if res.IsErr() {
+			t.Fatalf("%s: %s", name, res.Log)
+		}
+
+		var whois nameservice.WhoIs
+		cdc.MustUnmarshalJSON(res.Value, &whois)
+		if !whois.Owner.Equals(want) {
+			t.Errorf("%s is owned by %s, want %s", name, whois.Owner, want)
+		}
+	}
+
+	res := queryApp(t, nsApp, auth.QuerierRoute+"/"+auth.QueryAccount, cdc.MustMarshalJSON(auth.NewQueryAccountParams(bob)))
+	if res.IsErr() {
+		t.Fatalf("bob's account: %s", res.Log)
+	}
+	var account authexported.Account
+	cdc.MustUnmarshalJSON(res.Value, &account)
+	if !account.GetCoins().IsEqual(sdk.NewCoins(sdk.NewInt64Coin("nametoken", 50))) {
+		t.Errorf("bob's genesis account holds %s, want 50nametoken", account.GetCoins())
+	}
+}
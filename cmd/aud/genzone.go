@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+)
+
+const (
+	flagZone         = "zone"
+	flagDefaultOwner = "default-owner"
+)
+
+// AddGenesisZoneCmd returns add-genesis-zone cobra Command.
+func AddGenesisZoneCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+
+	cmd := &cobra.Command{
+		Use:   "add-genesis-zone [zone_file] [owners_file]",
+		Short: "Add the names of an RFC 1035 zone file to genesis.json",
+		Long: `Add every name found in an RFC 1035 zone file, with its A, AAAA, TXT and CNAME
+records, to the nameservice section of genesis.json. The owners file is a JSON object
+mapping each name to the address that owns it; names it doesn't list are given to
+--default-owner. Owners must already be genesis accounts. A name's value is taken from
+its first record.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			zoneFile, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer zoneFile.Close()
+
+			entries, err := dns.ParseZone(zoneFile, viper.GetString(flagZone))
+			if err != nil {
+				return fmt.Errorf("failed to parse zone file: %w", err)
+			}
+
+			ownersBz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var owners map[string]string
+			if err := json.Unmarshal(ownersBz, &owners); err != nil {
+				return fmt.Errorf("failed to parse owners file: %w", err)
+			}
+
+			var defaultOwner sdk.AccAddress
+			if owner := viper.GetString(flagDefaultOwner); owner != "" {
+				if defaultOwner, err = sdk.AccAddressFromBech32(owner); err != nil {
+					return fmt.Errorf("invalid default owner: %w", err)
+				}
+			}
+
+			records := make([]nameservice.GenesisWhoIs, 0, len(entries))
+			for _, entry := range entries {
+				owner := defaultOwner
+				if bech32, ok := owners[entry.Name]; ok {
+					if owner, err = sdk.AccAddressFromBech32(bech32); err != nil {
+						return fmt.Errorf("invalid owner for %s: %w", entry.Name, err)
+					}
+				}
+
+				if owner.Empty() {
+					return fmt.Errorf("no owner given for %s", entry.Name)
+				}
+
+				whois := nameservice.NewWhoIs()
+				whois.Owner = owner
+				whois.Value = entry.Records[0].Value
+				whois.Records = entry.Records
+
+				records = append(records, nameservice.GenesisWhoIs{Name: entry.Name, WhoIs: whois})
+			}
+
+			return addGenesisNames(cdc, config.GenesisFile(), records)
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagZone, dns.DefaultZone, "The zone the file's names are under")
+	cmd.Flags().String(flagDefaultOwner, "", "Address owning names missing from the owners file")
+
+	return cmd
+}
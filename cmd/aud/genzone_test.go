@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	nscli "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/cli"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+)
+
+const testZone = `$ORIGIN ns.
+$TTL 300
+@	IN	SOA	ns.ns. hostmaster.ns. 1 7200 3600 1209600 300
+@	IN	NS	ns.ns.
+alice	60	IN	A	10.0.0.1
+alice	60	IN	AAAA	fe80::1
+alice	60	IN	TXT	"hello world"
+www.alice	120	IN	CNAME	alice.ns.
+bob	IN	A	10.0.0.2
+`
+
+func TestGenesisZoneRoundTrip(t *testing.T) {
+	cdc := app.MakeCodec()
+	ctx := newTestHome(t, cdc)
+	genFile := ctx.Config.GenesisFile()
+	dir := filepath.Dir(genFile)
+
+	alice := sdk.AccAddress("alice-genesis-owner-")
+	bob := sdk.AccAddress("bob-genesis-owner---")
+
+	addAccount := AddGenesisAccountCmd(ctx, cdc, "", "")
+	for _, addr := range []sdk.AccAddress{alice, bob} {
+		if err := run(addAccount, addr.String(), "100nametoken"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	zoneFile := filepath.Join(dir, "ns.zone")
+	ownersFile := filepath.Join(dir, "owners.json")
+	if err := ioutil.WriteFile(zoneFile, []byte(testZone), 0600); err != nil {
+		t.Fatal(err)
+	}
+	owners := fmt.Sprintf(`{"alice": "%s", "www.alice": "%s"}`, alice, alice)
+	if err := ioutil.WriteFile(ownersFile, []byte(owners), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Set(flagZone, dns.DefaultZone)
+	addZone := AddGenesisZoneCmd(ctx, cdc, "")
+
+	// Bob Isn't In The Owners File, So Needs A Default Owner
+	if err := run(addZone, zoneFile, ownersFile); err == nil || !strings.Contains(err.Error(), "bob") {
+		t.Fatalf("got %v importing bob without an owner, want it refused", err)
+	}
+
+	viper.Set(flagDefaultOwner, bob.String())
+	if err := run(addZone, zoneFile, ownersFile); err != nil {
+		t.Fatal(err)
+	}
+
+	// A Name Without Records Is Exported With The One Its Value Implies
+	if err := run(AddGenesisNameCmd(ctx, cdc, "", ""), "carol", bob.String(), "10.0.0.3", "5nametoken"); err != nil {
+		t.Fatal(err)
+	}
+
+	genState := readGenesis(t, cdc, genFile)
+	byName := make(map[string]nameservice.WhoIs)
+	for _, record := range genState.WhoIsRecords {
+		byName[record.Name] = record.WhoIs
+	}
+	if whois := byName["alice"]; !whois.Owner.Equals(alice) || whois.Value != "10.0.0.1" || len(whois.Records) != 3 {
+		t.Errorf("alice was imported as %+v", whois)
+	}
+	if whois := byName["bob"]; !whois.Owner.Equals(bob) || len(whois.Records) != 1 || whois.Records[0].TTL != 300 {
+		t.Errorf("bob was imported as %+v, want the default owner and TTL", whois)
+	}
+
+	// Export The Names From A Chain Started On That Genesis
+	node, err := app.StartTestNode(genState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	viper.Set(flags.FlagNode, node.RPCAddress)
+	viper.Set(flags.FlagTrustNode, true)
+
+	var out bytes.Buffer
+	exportZone := nscli.GetCmdExportZone(nameservice.StoreKey, cdc)
+	exportZone.SetOut(&out)
+	if err := run(exportZone); err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := dns.ParseZone(&out, dns.DefaultZone)
+	if err != nil {
+		t.Fatalf("%v:\n%s", err, out.String())
+	}
+
+	imported, err := dns.ParseZone(strings.NewReader(testZone), dns.DefaultZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string][]nameservice.Record{"carol": {nameservice.RecordFromValue("10.0.0.3")}}
+	for _, entry := range imported {
+		want[entry.Name] = entry.Records
+	}
+
+	got := make(map[string][]nameservice.Record)
+	for _, entry := range exported {
+		got[entry.Name] = entry.Records
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("exported %+v, want %+v", got, want)
+	}
+}
@@ -1,6 +1,10 @@
 package nameservice
 
 import (
+	"fmt"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -11,7 +15,117 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 // 	TODO: fill out if your application requires beginblock, if not you can delete this function
 }
 
-// EndBlocker called every block, process inflation, update validator set.
+// EndBlocker settles the leases due by the next block: lessors are paid the
+// next period out of escrow, and leases that have run their course hand the
+// name back, releasing whatever escrow is left to the lessee. Harberger names
+// a tax period behind then pay their tax, or are foreclosed and auctioned,
+// and auctions are closed, their names going to the leading bidder or, with
+// no bids, left at the minimum price. Deleted names whose cooldown is over
+// are opened to anyone.
 func EndBlocker(ctx sdk.Context, k Keeper) {
-// 	TODO: fill out if your application requires endblock, if not you can delete this function
+	// Settled Now, So The Next Block Starts Under Their New Terms
+	for _, due := range k.DequeueLeases(ctx, ctx.BlockHeight()+1) {
+
+		// Ending A Lease Ends Its Subleases, Which May Have Fallen Due Alongside It
+		lease, found := k.GetLease(ctx, due.Name, due.Lessor)
+		if !found || !lease.Accepted() {
+			continue
+		}
+
+		var err error
+		if lease.PaidThrough >= lease.End || !lease.Escrow.IsAllGTE(lease.Fee) {
+			err = endLease(ctx, k, lease)
+		} else {
+			err = payLease(ctx, k, lease)
+		}
+
+		// Escrowed Coins Are Held By The Module Account, So Moving Them Can't Fail
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, name := range k.DequeueTaxes(ctx, ctx.BlockHeight()-k.GetParams(ctx).TaxPeriod) {
+
+		// Tax Is Paid Out Of Deposits Held By The Module Account, So This Can't Fail Either
+		if _, err := settleTax(ctx, k, name); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, auction := range k.DequeueAuctions(ctx, ctx.BlockHeight()) {
+		if auction.Bidding() {
+
+			// The Winning Bid Is Held By The Module Account, So Paying It Out Can't Fail
+			if err := awardAuction(ctx, k, auction); err != nil {
+				panic(err)
+			}
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeEndAuction,
+			sdk.NewAttribute(types.AttributeKeyName, auction.Name),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.Price(ctx.BlockHeight()).String()),
+		))
+	}
+
+	for _, cooldown := range k.DequeueCooldowns(ctx, ctx.BlockHeight()) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeEndCooldown,
+			sdk.NewAttribute(types.AttributeKeyName, cooldown.Name),
+		))
+	}
+}
+
+// payLease pays the lease's lessor its next period out of escrow
+func payLease(ctx sdk.Context, k Keeper, lease types.Lease) error {
+	if !lease.Fee.IsZero() {
+		err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lease.Lessor, lease.Fee)
+
+		// Error Occurred
+		if err != nil {
+			return err
+		}
+	}
+
+	lease.Escrow = lease.Escrow.Sub(lease.Fee)
+	lease.PaidThrough += lease.Period
+	k.SetLease(ctx, lease)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePayLease,
+		sdk.NewAttribute(types.AttributeKeyName, lease.Name),
+		sdk.NewAttribute(types.AttributeKeyLessor, lease.Lessor.String()),
+		sdk.NewAttribute(types.AttributeKeyLessee, lease.Lessee.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, lease.Fee.String()),
+		sdk.NewAttribute(types.AttributeKeyRemaining, lease.Escrow.String()),
+	))
+
+	return nil
+}
+
+// awardAuction hands the auctioned name to its leading bidder, paying the
+// winning bid into the community pool
+func awardAuction(ctx sdk.Context, k Keeper, auction types.Auction) error {
+	if err := k.FundCommunityPool(ctx, auction.Bid); err != nil {
+		return err
+	}
+
+	k.SetOwner(ctx, auction.Name, auction.Bidder)
+	k.SetPrice(ctx, auction.Name, auction.Bid)
+
+	if k.GetParams(ctx).HarbergerDefault {
+		startTax(ctx, k, auction.Name)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEndAuction,
+		sdk.NewAttribute(types.AttributeKeyName, auction.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, auction.Bidder.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, auction.Bid.String()),
+		sdk.NewAttribute(types.AttributeKeyExtended, fmt.Sprintf("%d", auction.Extended)),
+	))
+
+	return nil
 }
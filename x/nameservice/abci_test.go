@@ -0,0 +1,332 @@
+package nameservice
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// endBlocks ends n blocks, moving on to the height after each
+func (e *testEnv) endBlocks(n int) {
+	for i := 0; i < n; i++ {
+		EndBlocker(e.Ctx, e.Keeper)
+		e.Ctx = e.Ctx.WithBlockHeight(e.Ctx.BlockHeight() + 1)
+	}
+}
+
+// setParams changes the module's params through fn
+func (e *testEnv) setParams(fn func(*Params)) {
+	params := e.Keeper.GetParams(e.Ctx)
+	fn(&params)
+	e.Keeper.SetParams(e.Ctx, params)
+}
+
+// startAuction puts name, last priced at lastPrice, up for auction from the
+// current block
+func (e *testEnv) startAuction(name string, lastPrice int64) Auction {
+	params := e.Keeper.GetParams(e.Ctx)
+	auction := NewAuction(name, coins(lastPrice), params.AuctionStartMultiple, e.Ctx.BlockHeight(), params.AuctionBlocks)
+	e.Keeper.SetAuction(e.Ctx, auction)
+	return auction
+}
+
+func TestLeasePaidEachPeriod(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 10)
+	lessee := e.account("lessee", 30)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	e.must(NewMsgOfferLease("alice", lessee, 30, coins(10), 10, owner))
+	e.must(NewMsgAcceptLease("alice", owner, lessee))
+
+	// The First Period Is Paid Up Front, The Other Two Escrowed
+	e.assertBalance(owner, 10)
+	e.assertBalance(lessee, 0)
+	if got := e.ModuleBalance(); !got.IsEqual(coins(30)) {
+		t.Fatalf("module holds %s, want the name's and the lease's escrow", got)
+	}
+
+	// The Lessee Controls The Name, Which Can't Change Hands
+	e.must(NewMsgSetName("alice", "10.0.0.1", lessee))
+	e.fail(NewMsgSetName("alice", "10.0.0.2", owner), sdkerrors.ErrUnauthorized)
+	e.fail(NewMsgBuyName("alice", coins(20), e.account("buyer", 20)), sdkerrors.ErrUnauthorized)
+
+	e.endBlocks(10)
+	e.assertBalance(owner, 20)
+
+	e.endBlocks(20)
+	e.assertBalance(owner, 30)
+	if got := e.ModuleBalance(); !got.IsEqual(coins(10)) {
+		t.Fatalf("module holds %s after the lease, want the name's escrow", got)
+	}
+
+	// Control Returns To The Owner
+	e.fail(NewMsgSetName("alice", "10.0.0.3", lessee), sdkerrors.ErrUnauthorized)
+	e.must(NewMsgSetName("alice", "10.0.0.3", owner))
+}
+
+func TestLeaseEndedEarlyRefundsEscrow(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 10)
+	lessee := e.account("lessee", 30)
+	sublessee := e.account("sublessee", 0)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	e.must(NewMsgOfferLease("alice", lessee, 30, coins(10), 10, owner))
+	e.must(NewMsgAcceptLease("alice", owner, lessee))
+
+	// A Sublease Can't Outlast Its Lease
+	e.fail(NewMsgOfferLease("alice", sublessee, 40, coins(0), 0, lessee), sdkerrors.ErrUnknownRequest)
+
+	e.must(NewMsgOfferLease("alice", sublessee, 20, coins(0), 0, lessee))
+	e.must(NewMsgAcceptLease("alice", lessee, sublessee))
+	e.fail(NewMsgSetName("alice", "10.0.0.1", lessee), sdkerrors.ErrUnauthorized)
+
+	e.endBlocks(10)
+
+	// Only The Lessee Gives Up A Lease, Ending Its Subleases Too
+	e.fail(NewMsgEndLease("alice", owner, owner), sdkerrors.ErrUnauthorized)
+	e.must(NewMsgEndLease("alice", owner, lessee))
+
+	e.assertBalance(owner, 20)
+	e.assertBalance(lessee, 10)
+	e.fail(NewMsgSetName("alice", "10.0.0.1", sublessee), sdkerrors.ErrUnauthorized)
+	e.must(NewMsgSetName("alice", "10.0.0.1", owner))
+}
+
+func TestTaxPaidUntilForeclosure(t *testing.T) {
+	e := newTestEnv(t)
+	e.setParams(func(p *Params) { p.TaxPeriod = 10 })
+
+	owner := e.account("owner", 1100)
+	e.must(NewMsgBuyName("alice", coins(1000), owner))
+	e.must(NewMsgSelfAssess("alice", coins(1000), owner))
+	e.must(NewMsgDepositTax("alice", coins(25), owner))
+
+	// One Percent Of The Price Each Period, Out Of The Deposit, Paid As The Period Ends
+	e.endBlocks(11)
+	if got := e.Keeper.GetWhoIs(e.Ctx, "alice").Deposit; !got.IsEqual(coins(15)) {
+		t.Fatalf("deposit holds %s after a period, want 15nametoken", got)
+	}
+
+	e.fail(NewMsgWithdrawTax("alice", coins(20), owner), sdkerrors.ErrInsufficientFunds)
+
+	e.endBlocks(10)
+	if got := e.CommunityPool.Balance(e.Ctx); !got.IsEqual(coins(20)) {
+		t.Fatalf("community pool holds %s, want two periods' tax", got)
+	}
+
+	// A Deposit Short Of The Tax Forfeits It, And The Escrowed Price, As The Name Goes To Auction
+	e.endBlocks(10)
+	if e.Keeper.IsNamePresent(e.Ctx, "alice") {
+		t.Fatal("alice wasn't foreclosed")
+	}
+	if _, found := e.Keeper.GetAuction(e.Ctx, "alice"); !found {
+		t.Fatal("alice wasn't put up for auction")
+	}
+	if got := e.CommunityPool.Balance(e.Ctx); !got.IsEqual(coins(1025)) {
+		t.Fatalf("community pool holds %s, want the tax, the deposit and the escrow", got)
+	}
+	if got := e.ModuleBalance(); !got.IsZero() {
+		t.Fatalf("module still holds %s", got)
+	}
+	e.assertBalance(owner, 75)
+}
+
+func TestAuctionPriceFalls(t *testing.T) {
+	e := newTestEnv(t)
+	e.setParams(func(p *Params) {
+		p.AuctionBlocks = 100
+	})
+
+	buyer := e.account("buyer", 100)
+	e.startAuction("alice", 10)
+
+	// Ten Times The Last Price, Falling To The Minimum Over The Auction
+	e.fail(NewMsgBuyName("alice", coins(99), buyer), sdkerrors.ErrInsufficientFunds)
+
+	e.endBlocks(50)
+	e.fail(NewMsgBuyName("alice", coins(49), buyer), sdkerrors.ErrInsufficientFunds)
+	e.must(NewMsgBuyName("alice", coins(80), buyer))
+
+	// The Buyer Pays The Current Price, Into The Community Pool
+	e.assertBalance(buyer, 50)
+	if got := e.CommunityPool.Balance(e.Ctx); !got.IsEqual(coins(50)) {
+		t.Fatalf("community pool holds %s, want the price paid", got)
+	}
+	if _, found := e.Keeper.GetAuction(e.Ctx, "alice"); found {
+		t.Fatal("the auction outlived the sale")
+	}
+	if got := e.Keeper.GetOwner(e.Ctx, "alice"); !got.Equals(buyer) {
+		t.Fatalf("alice is owned by %s, want the buyer", got)
+	}
+
+	// The Auction Is Off The Queue Too, So Its End Doesn't Touch The Name
+	e.endBlocks(60)
+	if got := e.Keeper.GetOwner(e.Ctx, "alice"); !got.Equals(buyer) {
+		t.Fatalf("alice is owned by %s after the auction's end, want the buyer", got)
+	}
+}
+
+func TestAuctionBidsExtendTheClose(t *testing.T) {
+	e := newTestEnv(t)
+	e.setParams(func(p *Params) {
+		p.AuctionBidBlocks = 10
+		p.AuctionExtendWindow = 3
+		p.AuctionExtendBlocks = 3
+		p.AuctionMaxExtension = 5
+	})
+
+	b1 := e.account("b1", 200)
+	b2 := e.account("b2", 200)
+	e.startAuction("alice", 10)
+
+	closes := func(want int64) {
+		t.Helper()
+		auction, found := e.Keeper.GetAuction(e.Ctx, "alice")
+		if !found || auction.Close != want {
+			t.Fatalf("auction closes at %d, want %d", auction.Close, want)
+		}
+	}
+
+	// The First Bid Opens Bidding Until Block 11
+	e.must(NewMsgBuyName("alice", coins(100), b1))
+	closes(11)
+	e.fail(NewMsgBuyName("alice", coins(100), b2), sdkerrors.ErrInsufficientFunds)
+
+	// A Bid In The Final Block Pushes The Close Back, Refunding The Bid It Beat
+	e.endBlocks(9)
+	if res := e.must(NewMsgBuyName("alice", coins(110), b2)); !hasEvent(res, types.EventTypeExtendAuction) {
+		t.Error("extending the auction emitted no extend_auction event")
+	}
+	closes(14)
+	e.assertBalance(b1, 200)
+
+	// So Does Each Bid Sniping The New Close, Until The Extensions Reach Their Maximum
+	e.endBlocks(3)
+	e.must(NewMsgBuyName("alice", coins(120), b1))
+	closes(16)
+
+	e.endBlocks(2)
+	if res := e.must(NewMsgBuyName("alice", coins(130), b2)); hasEvent(res, types.EventTypeExtendAuction) {
+		t.Error("the auction was extended past its maximum")
+	}
+	closes(16)
+
+	// Bids At The Close Come Too Late
+	e.endBlocks(1)
+	e.fail(NewMsgBuyName("alice", coins(140), b1), sdkerrors.ErrUnauthorized)
+
+	// The EndBlocker Awards The Name To The Leading Bid, Which Goes To The Community Pool
+	e.endBlocks(1)
+	if _, found := e.Keeper.GetAuction(e.Ctx, "alice"); found {
+		t.Fatal("the auction is still running")
+	}
+	if got := e.Keeper.GetOwner(e.Ctx, "alice"); !got.Equals(b2) {
+		t.Fatalf("alice went to %s, want the leading bidder", got)
+	}
+	if got := e.Keeper.GetPrice(e.Ctx, "alice"); !got.IsEqual(coins(130)) {
+		t.Fatalf("alice is priced at %s, want the winning bid", got)
+	}
+	if got := e.CommunityPool.Balance(e.Ctx); !got.IsEqual(coins(130)) {
+		t.Fatalf("community pool holds %s, want the winning bid", got)
+	}
+	e.assertBalance(b1, 200)
+	e.assertBalance(b2, 70)
+	if got := e.ModuleBalance(); !got.IsZero() {
+		t.Fatalf("module still holds %s", got)
+	}
+}
+
+// TestAuctionBidsNearTheClose bids at random on an auction, mostly in and
+// around its extension window, checking each bid against a model of the rules
+func TestAuctionBidsNearTheClose(t *testing.T) {
+	const (
+		bidBlocks    = 20
+		extendWindow = 4
+		extendBlocks = 3
+		maxExtension = 9
+	)
+
+	for seed := int64(1); seed <= 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		e := newTestEnv(t)
+		e.setParams(func(p *Params) {
+			p.AuctionBidBlocks = bidBlocks
+			p.AuctionExtendWindow = extendWindow
+			p.AuctionExtendBlocks = extendBlocks
+			p.AuctionMaxExtension = maxExtension
+		})
+
+		bidders := []sdk.AccAddress{e.account("b1", 10000), e.account("b2", 10000), e.account("b3", 10000)}
+		e.startAuction("alice", 10)
+
+		// The Opening Bid Meets The Start Price, Opening The Window
+		leader, lead := bidders[0], int64(100)
+		e.must(NewMsgBuyName("alice", coins(lead), leader))
+		close, extended := e.Ctx.BlockHeight()+bidBlocks, int64(0)
+
+		// Skip Ahead To Just Before The Window, Where Sniping Happens
+		e.endBlocks(bidBlocks - extendWindow - 2)
+
+		for e.Ctx.BlockHeight() <= close {
+			height := e.Ctx.BlockHeight()
+
+			for i := r.Intn(3); i > 0; i-- {
+				bidder := bidders[r.Intn(len(bidders))]
+				bid := lead + int64(r.Intn(20)) - 4
+				msg := NewMsgBuyName("alice", coins(bid), bidder)
+
+				switch {
+				case height >= close:
+					e.fail(msg, sdkerrors.ErrUnauthorized)
+				case bid <= lead:
+					e.fail(msg, sdkerrors.ErrInsufficientFunds)
+				default:
+					e.must(msg)
+					leader, lead = bidder, bid
+
+					if close-height <= extendWindow {
+						extension := int64(extendBlocks)
+						if extension > maxExtension-extended {
+							extension = maxExtension - extended
+						}
+						close += extension
+						extended += extension
+					}
+				}
+
+				if auction, found := e.Keeper.GetAuction(e.Ctx, "alice"); height < close && (!found || auction.Close != close) {
+					t.Fatalf("seed %d: at block %d the auction closes at %d, want %d", seed, height, auction.Close, close)
+				}
+			}
+
+			e.endBlocks(1)
+		}
+
+		// Only The Winning Bid Is Kept, Paid Into The Community Pool
+		if _, found := e.Keeper.GetAuction(e.Ctx, "alice"); found {
+			t.Fatalf("seed %d: the auction outlived its close at %d", seed, close)
+		}
+		if got := e.Keeper.GetOwner(e.Ctx, "alice"); !got.Equals(leader) {
+			t.Fatalf("seed %d: alice went to %s, want the leading bidder %s", seed, got, leader)
+		}
+		if got := e.CommunityPool.Balance(e.Ctx); !got.IsEqual(coins(lead)) {
+			t.Fatalf("seed %d: community pool holds %s, want the winning bid %d", seed, got, lead)
+		}
+		for _, bidder := range bidders {
+			want := int64(10000)
+			if bidder.Equals(leader) {
+				want -= lead
+			}
+			e.assertBalance(bidder, want)
+		}
+		if got := e.ModuleBalance(); !got.IsZero() {
+			t.Fatalf("seed %d: module still holds %s", seed, got)
+		}
+	}
+}
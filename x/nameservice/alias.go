@@ -11,6 +11,18 @@ const (
 	ModuleName        = types.ModuleName
 	RouterKey         = types.RouterKey
 	StoreKey          = types.StoreKey
+	MaxBatchOperations = types.MaxBatchOperations
+	ScopeRecords      = types.ScopeRecords
+	ScopeManage       = types.ScopeManage
+	ActionSetValue    = types.ActionSetValue
+	ActionTransfer    = types.ActionTransfer
+	ActionSell        = types.ActionSell
+	ActionDelete      = types.ActionDelete
+	MaxOwners         = types.MaxOwners
+	MaxGuardians      = types.MaxGuardians
+	MinRecoveryDelay  = types.MinRecoveryDelay
+	MaxLeaseBlocks    = types.MaxLeaseBlocks
+	DefaultParamspace = types.DefaultParamspace
 )
 
 // Functions Aliases
@@ -21,7 +33,38 @@ var (
 	NewMsgSetName 		= types.NewMsgSetName
 	NewMsgBuyName 		= types.NewMsgBuyName
 	NewMsgDeleteName 	= types.NewMsgDeleteName
+	NewMsgSetRecords	= types.NewMsgSetRecords
+	NewMsgSendToName	= types.NewMsgSendToName
+	NewMsgBatch			= types.NewMsgBatch
+	NewBatchOperation	= types.NewBatchOperation
+	NewMsgApproveOperator	= types.NewMsgApproveOperator
+	NewMsgRevokeOperator	= types.NewMsgRevokeOperator
+	NewMsgGrantFeeAllowance	= types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance	= types.NewMsgRevokeFeeAllowance
+	NewMsgSetOwners		= types.NewMsgSetOwners
+	NewMsgPropose		= types.NewMsgPropose
+	NewMsgApproveProposal	= types.NewMsgApproveProposal
+	NewMsgSetGuardians	= types.NewMsgSetGuardians
+	NewMsgStartRecovery	= types.NewMsgStartRecovery
+	NewMsgApproveRecovery	= types.NewMsgApproveRecovery
+	NewMsgVetoRecovery	= types.NewMsgVetoRecovery
+	NewMsgFinalizeRecovery	= types.NewMsgFinalizeRecovery
+	NewMsgOfferLease	= types.NewMsgOfferLease
+	NewMsgAcceptLease	= types.NewMsgAcceptLease
+	NewMsgEndLease		= types.NewMsgEndLease
+	NewMsgSelfAssess	= types.NewMsgSelfAssess
+	NewMsgDepositTax	= types.NewMsgDepositTax
+	NewMsgWithdrawTax	= types.NewMsgWithdrawTax
+	NewParams			= types.NewParams
+	DefaultParams		= types.DefaultParams
+	NewAuction			= types.NewAuction
+	NewRecord			= types.NewRecord
+	RecordFromValue		= types.RecordFromValue
 	NewWhoIs			= types.NewWhoIs
+	NewGenesisState		= types.NewGenesisState
+	DefaultGenesisState	= types.DefaultGenesisState
+	ValidateGenesis		= types.ValidateGenesis
+	ValidateName		= types.ValidateName
 	RegisterCodec       = types.RegisterCodec
 )
 
@@ -39,7 +82,52 @@ type (
 	MsgSetName	 	= types.MsgSetName
 	MsgBuyName 	 	= types.MsgBuyName
 	MsgDeleteName	= types.MsgDeleteName
+	MsgSetRecords	= types.MsgSetRecords
+	MsgSendToName	= types.MsgSendToName
+	MsgBatch		= types.MsgBatch
+	BatchOperation	= types.BatchOperation
+	MsgApproveOperator	= types.MsgApproveOperator
+	MsgRevokeOperator	= types.MsgRevokeOperator
+	OperatorApproval	= types.OperatorApproval
+	QueryResOperators	= types.QueryResOperators
+	MsgGrantFeeAllowance	= types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance	= types.MsgRevokeFeeAllowance
+	FeeAllowance		= types.FeeAllowance
+	QueryResFeeAllowances	= types.QueryResFeeAllowances
+	MsgSetOwners		= types.MsgSetOwners
+	MsgPropose			= types.MsgPropose
+	MsgApproveProposal	= types.MsgApproveProposal
+	Proposal			= types.Proposal
+	ProposalAction		= types.ProposalAction
+	QueryResProposals	= types.QueryResProposals
+	MsgSetGuardians		= types.MsgSetGuardians
+	MsgStartRecovery	= types.MsgStartRecovery
+	MsgApproveRecovery	= types.MsgApproveRecovery
+	MsgVetoRecovery		= types.MsgVetoRecovery
+	MsgFinalizeRecovery	= types.MsgFinalizeRecovery
+	GuardianSet			= types.GuardianSet
+	Recovery			= types.Recovery
+	QueryResRecoveries	= types.QueryResRecoveries
+	MsgOfferLease		= types.MsgOfferLease
+	MsgAcceptLease		= types.MsgAcceptLease
+	MsgEndLease			= types.MsgEndLease
+	Lease				= types.Lease
+	QueryResLeases		= types.QueryResLeases
+	MsgSelfAssess		= types.MsgSelfAssess
+	MsgDepositTax		= types.MsgDepositTax
+	MsgWithdrawTax		= types.MsgWithdrawTax
+	Auction				= types.Auction
+	QueryResAuction		= types.QueryResAuction
+	Cooldown			= types.Cooldown
+	QueryResStatus		= types.QueryResStatus
+	Record			= types.Record
 	QueryResResolve = types.QueryResResolve
 	QueryResNames	= types.QueryResNames
-	whoIs			= types.WhoIs
+	QueryResRecords	= types.QueryResRecords
+	QueryResAddress	= types.QueryResAddress
+	QueryResOwnedBy	= types.QueryResOwnedBy
+	OwnedNames		= types.OwnedNames
+	WhoIs			= types.WhoIs
+	GenesisState	= types.GenesisState
+	GenesisWhoIs	= types.GenesisWhoIs
 )
@@ -0,0 +1,57 @@
+package nameservice
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// FeeAllowanceDecorator pays the fee of a tx made up only of nameservice msgs
+// out of an allowance granted to its fee payer. It goes in auth's decorator
+// chain after the SetUpContextDecorator, so the allowances it reads are paid
+// for in gas, and before the DeductFeeDecorator: the fee is moved from the
+// allowance to the fee payer, whose account it is then deducted from as
+// usual. A tx no allowance covers, or whose fee is over an allowance's spend
+// limit, is left for its fee payer to pay. Granting an allowance creates the
+// grantee's account, so the SetPubKeyDecorator ahead of this one finds it.
+type FeeAllowanceDecorator struct {
+	keeper Keeper
+}
+
+func NewFeeAllowanceDecorator(k Keeper) FeeAllowanceDecorator {
+	return FeeAllowanceDecorator{keeper: k}
+}
+
+func (d FeeAllowanceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok || !nameserviceOnly(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	// Invalid Fees Are Rejected By The Auth Ante Handler
+	fee := feeTx.GetFee()
+	if fee.IsZero() || !fee.IsValid() {
+		return next(ctx, tx, simulate)
+	}
+
+	// Should The Tx Fail Its Checks, The Allowance Is Restored With The Rest Of Its State
+	if _, err := d.keeper.UseFeeAllowance(ctx, feeTx.FeePayer(), fee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// nameserviceOnly reports whether every msg of tx is routed to this module
+func nameserviceOnly(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		if msg.Route() != RouterKey {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,127 @@
+package nameservice
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestFeeAllowanceDecoratorIsChargedGas(t *testing.T) {
+	e := newTestEnv(t)
+	granter := e.account("granter", 100)
+	grantee := e.account("grantee", 0)
+
+	e.must(NewMsgGrantFeeAllowance(grantee, coins(10), nil, time.Time{}, granter))
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewSetUpContextDecorator(), NewFeeAllowanceDecorator(e.Keeper))
+	tx := func(gas uint64) sdk.Tx {
+		msg := NewMsgSetName("alice", "10.0.0.1", grantee)
+		return auth.NewStdTx([]sdk.Msg{msg}, auth.NewStdFee(gas, coins(4)), nil, "")
+	}
+
+	// Out Of Gas Reading The Allowance, Which Is Left Untouched
+	ctx, _ := e.Ctx.CacheContext()
+	if _, err := anteHandler(ctx, tx(10), false); !sdkerrors.ErrOutOfGas.Is(err) {
+		t.Fatalf("got %v, want out of gas", err)
+	}
+
+	ctx, write := e.Ctx.CacheContext()
+	ctx, err := anteHandler(ctx, tx(100000), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	write()
+
+	if ctx.GasMeter().GasConsumed() == 0 {
+		t.Error("the allowance was used without charging gas")
+	}
+
+	e.assertBalance(grantee, 4)
+	if allowance, _ := e.Keeper.GetFeeAllowance(e.Ctx, grantee, granter); !allowance.Remaining.IsEqual(coins(6)) {
+		t.Errorf("allowance has %s left, want 6nametoken", allowance.Remaining)
+	}
+}
+
+// authAnteHandler is the app's decorator chain, without the checks on the
+// tx itself, so the allowance is used before the fee payer's account is
+// charged and its signature checked
+func (e *testEnv) authAnteHandler() sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(),
+		ante.NewSetPubKeyDecorator(e.AccountKeeper),
+		ante.NewValidateSigCountDecorator(e.AccountKeeper),
+		NewFeeAllowanceDecorator(e.Keeper),
+		ante.NewDeductFeeDecorator(e.AccountKeeper, e.SupplyKeeper),
+		ante.NewSigGasConsumeDecorator(e.AccountKeeper, auth.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(e.AccountKeeper),
+		ante.NewIncrementSequenceDecorator(e.AccountKeeper),
+	)
+}
+
+// signedTx is msg, with fee, signed by priv at its account's number and sequence
+func (e *testEnv) signedTx(priv secp256k1.PrivKeySecp256k1, msg sdk.Msg, fee int64) sdk.Tx {
+	e.t.Helper()
+
+	acc := e.AccountKeeper.GetAccount(e.Ctx, sdk.AccAddress(priv.PubKey().Address()))
+	if acc == nil {
+		e.t.Fatal("the signer has no account")
+	}
+
+	stdFee := auth.NewStdFee(200000, coins(fee))
+	msgs := []sdk.Msg{msg}
+	sig, err := priv.Sign(auth.StdSignBytes(e.Ctx.ChainID(), acc.GetAccountNumber(), acc.GetSequence(), stdFee, msgs, ""))
+	if err != nil {
+		e.t.Fatal(err)
+	}
+
+	return auth.NewStdTx(msgs, stdFee, []auth.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, "")
+}
+
+func TestFeeAllowancePaysForGranteeWithoutAccount(t *testing.T) {
+	e := newTestEnv(t)
+	granter := e.account("granter", 100)
+	priv := secp256k1.GenPrivKey()
+	grantee := sdk.AccAddress(priv.PubKey().Address())
+
+	e.must(NewMsgGrantFeeAllowance(grantee, coins(10), nil, time.Time{}, granter))
+
+	tx := e.signedTx(priv, NewMsgSetName("alice", "10.0.0.1", grantee), 4)
+	if _, err := e.authAnteHandler()(e.Ctx, tx, false); err != nil {
+		t.Fatal(err)
+	}
+
+	e.assertBalance(grantee, 0)
+	e.assertBalance(e.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName), 4)
+	if allowance, _ := e.Keeper.GetFeeAllowance(e.Ctx, grantee, granter); !allowance.Remaining.IsEqual(coins(6)) {
+		t.Errorf("allowance has %s left, want 6nametoken", allowance.Remaining)
+	}
+}
+
+func TestFeeAllowanceSpendLimit(t *testing.T) {
+	e := newTestEnv(t)
+	granter := e.account("granter", 100)
+	priv := secp256k1.GenPrivKey()
+	grantee := sdk.AccAddress(priv.PubKey().Address())
+
+	e.must(NewMsgGrantFeeAllowance(grantee, coins(10), coins(3), time.Time{}, granter))
+	msg := NewMsgSetName("alice", "10.0.0.1", grantee)
+
+	// Over The Limit, The Fee Is Left To The Grantee, Who Can't Pay It
+	ctx, _ := e.Ctx.CacheContext()
+	if _, err := e.authAnteHandler()(ctx, e.signedTx(priv, msg, 4), false); !sdkerrors.ErrInsufficientFunds.Is(err) {
+		t.Fatalf("got %v, want insufficient funds", err)
+	}
+
+	if _, err := e.authAnteHandler()(e.Ctx, e.signedTx(priv, msg, 3), false); err != nil {
+		t.Fatal(err)
+	}
+
+	if allowance, _ := e.Keeper.GetFeeAllowance(e.Ctx, grantee, granter); !allowance.Remaining.IsEqual(coins(7)) {
+		t.Errorf("allowance has %s left, want 7nametoken", allowance.Remaining)
+	}
+}
@@ -0,0 +1,310 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+const (
+	flagFormat          = "format"
+	flagGasPerOperation = "gas-per-operation"
+	flagMaxTxGas        = "max-tx-gas"
+
+	formatCSV  = "csv"
+	formatJSON = "json"
+
+	// Gas A Tx Uses Before Its First Operation, For Signature Checks And Fees
+	batchBaseGas uint64 = 100000
+)
+
+// Operations A Batch File May List, Named After Their Commands
+const (
+	opSetName    = "set-name"
+	opBuyName    = "buy-name"
+	opDeleteName = "delete-name"
+	opSetRecords = "set-records"
+)
+
+// batchEntry is one line of a batch file
+type batchEntry struct {
+	Op      string         `json:"op"`
+	Name    string         `json:"name"`
+	Value   string         `json:"value,omitempty"`
+	Bid     string         `json:"bid,omitempty"`
+	Records []types.Record `json:"records,omitempty"`
+}
+
+func GetCmdBatch(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch [file]",
+		Short: "Apply The set-name, buy-name, delete-name And set-records Operations Listed In A File",
+		Long: `Apply the operations listed in a CSV or JSON file, batched into as few txs as fit
+under the block gas limit. Each tx applies its operations all or none.
+
+A CSV file has one operation per line, the operation followed by its arguments as on
+the command line, e.g.
+
+	set-name,alice,10.0.0.1
+	buy-name,bob,10nametoken
+	delete-name,carol
+	set-records,dave,A=10.0.0.1,TXT=hello
+
+A JSON file is a list of operations, e.g.
+
+	[{"op": "set-name", "name": "alice", "value": "10.0.0.1"},
+	 {"op": "set-records", "name": "dave", "records": [{"type": "A", "value": "10.0.0.1", "ttl": 60}]}]
+
+Txs are sized from --gas-per-operation, unless --gas=auto simulates each of them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			entries, err := readBatchFile(args[0], viper.GetString(flagFormat))
+			if err != nil {
+				return err
+			}
+
+			ops := make([]types.BatchOperation, len(entries))
+			for i, entry := range entries {
+				ops[i], err = entry.operation(cliCtx.GetFromAddress(), uint32(viper.GetUint(flagTTL)))
+				if err != nil {
+					return fmt.Errorf("entry %d: %w", i, err)
+				}
+			}
+
+			maxTxGas := viper.GetUint64(flagMaxTxGas)
+			if maxTxGas == 0 && !cliCtx.GenerateOnly {
+				if maxTxGas, err = blockMaxGas(cliCtx); err != nil {
+					return err
+				}
+			}
+
+			perTx, err := operationsPerTx(maxTxGas, viper.GetUint64(flagGasPerOperation))
+			if err != nil {
+				return err
+			}
+
+			var msgs []sdk.Msg
+			for start := 0; start < len(ops); start += perTx {
+				end := start + perTx
+				if end > len(ops) {
+					end = len(ops)
+				}
+
+				msg := types.NewMsgBatch(ops[start:end], cliCtx.GetFromAddress())
+
+				// State-less Checks
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+
+				msgs = append(msgs, msg)
+			}
+
+			return broadcastBatches(cliCtx, txBldr, msgs, viper.GetUint64(flagGasPerOperation))
+		},
+	}
+
+	cmd.Flags().String(flagFormat, "", "Format of the file, csv or json (default from its extension)")
+	cmd.Flags().Uint64(flagGasPerOperation, 50000, "Gas an operation is expected to use, to size the txs")
+	cmd.Flags().Uint64(flagMaxTxGas, 0, "Most gas a tx may use (default the block gas limit)")
+	cmd.Flags().Uint(flagTTL, uint(types.DefaultRecordTTL), "TTL (seconds) of CSV records, and of JSON records that don't set one")
+
+	return cmd
+}
+
+// broadcastBatches sends each batch in its own tx, numbering their sequences
+// up front so later txs needn't wait for earlier ones to be committed
+func broadcastBatches(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg, gasPerOp uint64) error {
+	if !cliCtx.GenerateOnly {
+		var err error
+		if txBldr, err = utils.PrepareTxBuilder(txBldr, cliCtx); err != nil {
+			return err
+		}
+	}
+
+	for i, msg := range msgs {
+		bldr := txBldr.WithSequence(txBldr.Sequence() + uint64(i))
+		if !bldr.SimulateAndExecute() {
+			bldr = bldr.WithGas(batchBaseGas + gasPerOp*uint64(len(msg.(types.MsgBatch).Operations)))
+		}
+
+		if err := utils.GenerateOrBroadcastMsgs(cliCtx, bldr, []sdk.Msg{msg}); err != nil {
+			return fmt.Errorf("tx %d of %d: %w", i+1, len(msgs), err)
+		}
+	}
+
+	return nil
+}
+
+// blockMaxGas is the node's block gas limit, or 0 if there is none
+func blockMaxGas(cliCtx context.CLIContext) (uint64, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := node.ConsensusParams(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	if res.ConsensusParams.Block.MaxGas <= 0 {
+		return 0, nil
+	}
+	return uint64(res.ConsensusParams.Block.MaxGas), nil
+}
+
+// operationsPerTx is how many operations fit in a tx under maxTxGas, 0 being
+// no limit
+func operationsPerTx(maxTxGas, gasPerOp uint64) (int, error) {
+	if maxTxGas == 0 || gasPerOp == 0 {
+		return types.MaxBatchOperations, nil
+	}
+
+	if maxTxGas < batchBaseGas+gasPerOp {
+		return 0, fmt.Errorf("a tx of %d gas can't fit an operation of %d gas", maxTxGas, gasPerOp)
+	}
+
+	perTx := (maxTxGas - batchBaseGas) / gasPerOp
+	if perTx > types.MaxBatchOperations {
+		return types.MaxBatchOperations, nil
+	}
+	return int(perTx), nil
+}
+
+// readBatchFile reads the entries of a batch file in format, or the format
+// its extension names
+func readBatchFile(path, format string) ([]batchEntry, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []batchEntry
+	switch format {
+	case formatCSV:
+		entries, err = readBatchCSV(file)
+	case formatJSON:
+		err = json.NewDecoder(file).Decode(&entries)
+	default:
+		return nil, fmt.Errorf("unsupported batch file format %q, expected csv or json", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%s lists no operations", path)
+	}
+	return entries, nil
+}
+
+func readBatchCSV(r io.Reader) ([]batchEntry, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+
+	lines, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]batchEntry, len(lines))
+	for i, line := range lines {
+		if len(line) < 2 {
+			return nil, fmt.Errorf("line %d: expected an operation and a name", i+1)
+		}
+
+		entry := batchEntry{Op: line[0], Name: line[1]}
+		args := line[2:]
+
+		switch entry.Op {
+		case opSetName:
+			if len(args) != 1 {
+				return nil, fmt.Errorf("line %d: %s takes a name and a value", i+1, entry.Op)
+			}
+			entry.Value = args[0]
+		case opBuyName:
+			if len(args) != 1 {
+				return nil, fmt.Errorf("line %d: %s takes a name and a bid", i+1, entry.Op)
+			}
+			entry.Bid = args[0]
+		case opDeleteName:
+			if len(args) != 0 {
+				return nil, fmt.Errorf("line %d: %s takes only a name", i+1, entry.Op)
+			}
+		case opSetRecords:
+			for _, arg := range args {
+				parts := strings.SplitN(arg, "=", 2)
+				if len(parts) != 2 {
+					return nil, fmt.Errorf("line %d: invalid record %q, expected TYPE=VALUE", i+1, arg)
+				}
+
+				// The TTL Is Filled In From --ttl
+				entry.Records = append(entry.Records, types.Record{Type: parts[0], Value: parts[1]})
+			}
+		}
+
+		entries[i] = entry
+	}
+
+	return entries, nil
+}
+
+// operation is the batch operation entry describes, made by from
+func (entry batchEntry) operation(from sdk.AccAddress, ttl uint32) (types.BatchOperation, error) {
+	var msg sdk.Msg
+
+	switch entry.Op {
+	case opSetName:
+		msg = types.NewMsgSetName(entry.Name, entry.Value, from)
+	case opBuyName:
+		bid, err := sdk.ParseCoins(entry.Bid)
+		if err != nil {
+			return types.BatchOperation{}, err
+		}
+		msg = types.NewMsgBuyName(entry.Name, bid, from)
+	case opDeleteName:
+		msg = types.NewMsgDeleteName(entry.Name, from)
+	case opSetRecords:
+		records := make([]types.Record, len(entry.Records))
+		for i, record := range entry.Records {
+			if record.TTL == 0 {
+				record.TTL = ttl
+			}
+			records[i] = record
+		}
+		msg = types.NewMsgSetRecords(entry.Name, records, from)
+	default:
+		return types.BatchOperation{}, fmt.Errorf("unknown operation %q, expected %s, %s, %s or %s",
+			entry.Op, opSetName, opBuyName, opDeleteName, opSetRecords)
+	}
+
+	return types.NewBatchOperation(msg)
+}
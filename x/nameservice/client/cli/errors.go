@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/viper"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Exit Codes Of A Failed Command
+const (
+	// Anything Else, Like The Node Being Unreachable Or A Bad Argument
+	ExitFailure = 1
+	// The Name Queried Isn't Registered
+	ExitNotFound = 2
+)
+
+// QueryError is a query the node answered with an error, keeping the
+// codespace and code it was registered with
+type QueryError struct {
+	Codespace string `json:"codespace,omitempty"`
+	Code      uint32 `json:"code,omitempty"`
+	Message   string `json:"message"`
+}
+
+func (e *QueryError) Error() string {
+	return e.Message
+}
+
+// ExitCode implements tendermint's cli.ExitCoder
+func (e *QueryError) ExitCode() int {
+	if e.Codespace == types.ModuleName && e.Code == types.ErrNameDoesNotExist.ABCICode() {
+		return ExitNotFound
+	}
+	return ExitFailure
+}
+
+// ExitCode is the code the cli exits with after err
+func ExitCode(err error) int {
+	var coder tmcli.ExitCoder
+	if errors.As(err, &coder) {
+		return coder.ExitCode()
+	}
+	return ExitFailure
+}
+
+// PrintError writes err to w, as {"error": {...}} when --output is json so
+// scripts can tell failures apart without parsing messages
+func PrintError(w io.Writer, err error) {
+	if viper.GetString(tmcli.OutputFlag) != OutputJSON {
+		if viper.GetBool(tmcli.TraceFlag) {
+			fmt.Fprintf(w, "ERROR: %+v\n", err)
+		} else {
+			fmt.Fprintf(w, "ERROR: %v\n", err)
+		}
+		return
+	}
+
+	queryErr := &QueryError{Message: err.Error()}
+	errors.As(err, &queryErr)
+
+	out, jsonErr := json.Marshal(struct {
+		Error    *QueryError `json:"error"`
+		ExitCode int         `json:"exit_code"`
+	}{queryErr, ExitCode(err)})
+	if jsonErr != nil {
+		fmt.Fprintf(w, "ERROR: %v\n", err)
+		return
+	}
+
+	fmt.Fprintln(w, string(out))
+}
+
+// query runs a custom query like cliCtx.QueryWithData, but fails with a
+// QueryError so the error's code survives
+func query(cliCtx context.CLIContext, path string) ([]byte, int64, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Custom Query Results Carry No Proof, So There Is Nothing To Verify
+	result, err := node.ABCIQueryWithOptions(path, nil, rpcclient.ABCIQueryOptions{Height: cliCtx.Height})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return nil, resp.Height, &QueryError{Codespace: resp.Codespace, Code: resp.Code, Message: resp.Log}
+	}
+
+	return resp.Value, resp.Height, nil
+}
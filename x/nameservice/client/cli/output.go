@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+// Output Formats Of Nameservice Queries. text is the SDK's name for yaml.
+const (
+	OutputText  = "text"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+	OutputTable = "table"
+	OutputCSV   = "csv"
+)
+
+const (
+	flagQuiet = "quiet"
+
+	// Marks The Commands That Print Through queryOutput, So Know Every Format
+	annotationOutput = "nameservice-output"
+)
+
+// ValidateOutput checks --output against the formats cmd can print. Every
+// command prints text and json, and yaml is taken as text. table and csv are
+// only printed by the nameservice queries.
+func ValidateOutput(cmd *cobra.Command, args []string) error {
+	switch format := viper.GetString(tmcli.OutputFlag); format {
+	case OutputText, OutputJSON:
+	case OutputYAML:
+		// The SDK Prints yaml Under The Name text
+		viper.Set(tmcli.OutputFlag, OutputText)
+	case OutputTable, OutputCSV:
+		if _, ok := cmd.Annotations[annotationOutput]; !ok {
+			return fmt.Errorf("%s doesn't support output format %s", cmd.CommandPath(), format)
+		}
+	default:
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+	return nil
+}
+
+// ExtendOutputFormats lets cmd, prepared by tendermint's cli.PrepareMainCmd,
+// take every --output format ValidateOutput accepts. PrepareMainCmd's own
+// check only knows text and json, so the other formats are hidden from it.
+func ExtendOutputFormats(cmd *cobra.Command) {
+	cmd.PersistentFlags().Lookup(tmcli.OutputFlag).Usage = "Output format (text|json|yaml; nameservice queries also table|csv)"
+
+	preRun := cmd.PersistentPreRunE
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		flag := cmd.Flags().Lookup(tmcli.OutputFlag)
+		format := flag.Value.String()
+
+		if format != OutputText && format != OutputJSON {
+			if err := flag.Value.Set(OutputText); err != nil {
+				return err
+			}
+		}
+
+		err := preRun(cmd, args)
+
+		// Viper Reads The Flag On Every Get, So Restoring It Is Enough
+		if setErr := flag.Value.Set(format); err == nil {
+			err = setErr
+		}
+		if err != nil {
+			return err
+		}
+
+		return ValidateOutput(cmd, args)
+	}
+}
+
+// withOutputFlags marks cmd as printing every output format and adds --quiet
+func withOutputFlags(cmd *cobra.Command, quiet string) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = make(map[string]string)
+	}
+	cmd.Annotations[annotationOutput] = ""
+
+	cmd.Flags().BoolP(flagQuiet, "q", false, quiet)
+
+	return cmd
+}
+
+// queryOutput is a query's result in the shape of each output format
+type queryOutput struct {
+	// Printed As json Or yaml
+	value interface{}
+
+	// Printed As table Or csv
+	header []string
+	rows   [][]string
+
+	// Printed One Per Line Under --quiet
+	quiet []string
+}
+
+// printer prints query results to a command's stdout. The table or csv header
+// is printed once, so a stream of results reads as one table.
+type printer struct {
+	cliCtx context.CLIContext
+	out    io.Writer
+	quiet  bool
+
+	headerDone bool
+}
+
+func newPrinter(cmd *cobra.Command, cliCtx context.CLIContext) *printer {
+	return &printer{cliCtx: cliCtx, out: cmd.OutOrStdout(), quiet: viper.GetBool(flagQuiet)}
+}
+
+func (p *printer) print(output queryOutput) error {
+	if p.quiet {
+		for _, line := range output.quiet {
+			if _, err := fmt.Fprintln(p.out, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch p.cliCtx.OutputFormat {
+	case OutputTable:
+		return p.printTable(output)
+	case OutputCSV:
+		return p.printCSV(output)
+	default:
+		return p.cliCtx.PrintOutput(output.value)
+	}
+}
+
+func (p *printer) printTable(output queryOutput) error {
+	w := tabwriter.NewWriter(p.out, 0, 4, 2, ' ', 0)
+
+	if !p.headerDone {
+		header := make([]string, len(output.header))
+		for i, column := range output.header {
+			header[i] = strings.ToUpper(column)
+		}
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		p.headerDone = true
+	}
+
+	for _, row := range output.rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	return w.Flush()
+}
+
+func (p *printer) printCSV(output queryOutput) error {
+	w := csv.NewWriter(p.out)
+
+	if !p.headerDone {
+		if err := w.Write(output.header); err != nil {
+			return err
+		}
+		p.headerDone = true
+	}
+
+	if err := w.WriteAll(output.rows); err != nil {
+		return err
+	}
+
+	return w.Error()
+}
@@ -0,0 +1,120 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/cli"
+)
+
+func TestQueryOutputAgainstNode(t *testing.T) {
+	node, err := app.StartTestNode(nameservice.DefaultGenesisState())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10))
+	if err := node.Deliver(
+		nameservice.NewMsgBuyName("alice", price, node.Account),
+		nameservice.NewMsgSetName("alice", "10.0.0.1", node.Account),
+		nameservice.NewMsgBuyName("bob", price, node.Account),
+		nameservice.NewMsgSetName("bob", "10.0.0.2", node.Account),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	defer viper.Reset()
+	viper.Set(flags.FlagNode, node.RPCAddress)
+	viper.Set(flags.FlagTrustNode, true)
+
+	queryCmd := cli.GetQueryCmd(nameservice.StoreKey, node.Cdc)
+
+	// run runs a query under --output format, returning what it printed
+	run := func(format string, quiet bool, args ...string) (string, error) {
+		t.Helper()
+
+		cmd, args, err := queryCmd.Find(args)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		viper.Set(tmcli.OutputFlag, format)
+		viper.Set("quiet", quiet)
+		if err := cli.ValidateOutput(cmd, args); err != nil {
+			return "", err
+		}
+
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		err = cmd.RunE(cmd, args)
+		return out.String(), err
+	}
+
+	out, err := run(cli.OutputCSV, false, "whois", "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "name,value,owner,price,records\nalice,10.0.0.1," + node.Account.String() + ",10nametoken,\n"
+	if out != want {
+		t.Errorf("whois --output csv printed\n%s\nwant\n%s", out, want)
+	}
+
+	out, err = run(cli.OutputTable, false, "get", "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimSpace(out), "\n"); len(lines) != 2 || strings.Fields(lines[0])[0] != "NAME" || strings.Join(strings.Fields(lines[1]), " ") != "bob 10.0.0.2" {
+		t.Errorf("get --output table printed\n%s", out)
+	}
+
+	if out, err = run(cli.OutputText, true, "names"); err != nil || out != "alice\nbob\n" {
+		t.Errorf("names --quiet printed %q (%v), want one name per line", out, err)
+	}
+
+	// An Unregistered Name Exits With Its Own Code, Reported As JSON Under --output json
+	_, err = run(cli.OutputJSON, false, "whois", "carol")
+	if code := cli.ExitCode(err); code != cli.ExitNotFound {
+		t.Fatalf("got exit code %d (%v) for an unregistered name, want %d", code, err, cli.ExitNotFound)
+	}
+
+	var printed bytes.Buffer
+	cli.PrintError(&printed, err)
+
+	var report struct {
+		Error    cli.QueryError `json:"error"`
+		ExitCode int            `json:"exit_code"`
+	}
+	if err := json.Unmarshal(printed.Bytes(), &report); err != nil {
+		t.Fatalf("%v: %s", err, printed.String())
+	}
+	if report.Error.Codespace != nameservice.ModuleName || report.ExitCode != cli.ExitNotFound {
+		t.Errorf("the error was reported as %s", printed.String())
+	}
+
+	// Other Failures Exit With The Generic Code
+	if _, err := run(cli.OutputText, false, "owned", "not-an-address"); err == nil || cli.ExitCode(err) != cli.ExitFailure {
+		t.Errorf("got exit code %d (%v) for an invalid address, want %d", cli.ExitCode(err), err, cli.ExitFailure)
+	}
+
+	// Only The Nameservice Queries Print Tables
+	if _, err := run("xml", false, "names"); err == nil {
+		t.Error("an unknown output format was accepted")
+	}
+	viper.Set(tmcli.OutputFlag, cli.OutputTable)
+	if err := cli.ValidateOutput(&cobra.Command{Use: "other"}, nil); err == nil {
+		t.Error("table output was accepted by a command that can't print it")
+	}
+}
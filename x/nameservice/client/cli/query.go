@@ -1,24 +1,49 @@
 package cli
 
 import (
+	stdcontext "context"
 	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/watch"
 	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
 )
 
+const (
+	flagZone  = "zone"
+	flagOut   = "out"
+	flagName  = "name"
+	flagOwner = "owner"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group nameservice queries under a subcommand
 	nameserviceQueryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
 		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		Long: fmt.Sprintf(`Querying commands for the %s module.
+
+Queries print in any --output format: text or yaml, json, table or csv, and
+--quiet prints only the value a script wants, one per line. A failed query
+exits with %d, or %d if the name isn't registered; with --output json the
+error is printed to stderr as {"error": {"codespace", "code", "message"}}.`, types.ModuleName, ExitFailure, ExitNotFound),
 		DisableFlagParsing:         true,
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
@@ -32,6 +57,20 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdGetName(queryRoute, cdc),
 			GetCmdWhoIs(queryRoute, cdc),
 			GetCmdNames(queryRoute, cdc),
+			GetCmdRecords(queryRoute, cdc),
+			GetCmdAddress(queryRoute, cdc),
+			GetCmdOwned(queryRoute, cdc),
+			GetCmdOperators(queryRoute, cdc),
+			GetCmdFeeAllowances(queryRoute, cdc),
+			GetCmdProposals(queryRoute, cdc),
+			GetCmdGuardians(queryRoute, cdc),
+			GetCmdRecoveries(queryRoute, cdc),
+			GetCmdLeases(queryRoute, cdc),
+			GetCmdParams(queryRoute, cdc),
+			GetCmdAuction(queryRoute, cdc),
+			GetCmdStatus(queryRoute, cdc),
+			GetCmdWatch(cdc),
+			GetCmdExportZone(queryRoute, cdc),
 		)...,
 	)
 
@@ -41,30 +80,34 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 // Define cobra.Commands For Each Module's Added Querier Command
 
 func GetCmdGetName(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command {
+	return withOutputFlags(&cobra.Command {
 		Use: "get [name]",
-		Short: "get name",
+		Short: "Query the value name resolves to",
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			name := args[0]
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/get/%s", queryRoute, name), nil)
-			
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/resolve/%s", queryRoute, name))
 			if err != nil {
-				fmt.Sprintf("Couldn't get name - %s \n", name)
-				return nil
+				return err
 			}
 
-			var output types.QueryResResolve
-			cdc.MustUnmarshalJSON(res, &output)
-			return cliCtx.PrintOutput(output)
+			var out types.QueryResResolve
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return newPrinter(cmd, cliCtx).print(queryOutput{
+				value:  out,
+				header: []string{"name", "value"},
+				rows:   [][]string{{name, out.Value}},
+				quiet:  []string{out.Value},
+			})
 		},
-	}
+	}, "Print only the value")
 }
 
 func GetCmdWhoIs(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command {
+	return withOutputFlags(&cobra.Command {
 		Use: "whois [name]",
 		Short: "Query whois info of name",
 		Args: cobra.ExactArgs(1),
@@ -72,38 +115,601 @@ func GetCmdWhoIs(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			name := args[0]
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/whois/%s", queryRoute, name), nil)
-		
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/whois/%s", queryRoute, name))
 			if err != nil {
-				fmt.Sprintf("Couldn't Resolve whoIs - %s \n", name)
-				return nil
+				return err
+			}
+
+			var out types.WhoIs
+			cdc.MustUnmarshalJSON(res, &out)
+
+			// The whois Query Answers Unknown Names With An Unowned Default
+			if out.Owner.Empty() {
+				return &QueryError{
+					Codespace: types.ModuleName,
+					Code:      types.ErrNameDoesNotExist.ABCICode(),
+					Message:   sdkerrors.Wrap(types.ErrNameDoesNotExist, name).Error(),
+				}
 			}
 
-			var output types.WhoIs
-			cdc.MustUnmarshalJSON(res, &output)
-			return cliCtx.PrintOutput(output)
+			return newPrinter(cmd, cliCtx).print(queryOutput{
+				value:  out,
+				header: []string{"name", "value", "owner", "price", "records"},
+				rows:   [][]string{{name, out.Value, ownersCell(out), out.Price.String(), types.QueryResRecords(out.Records).String()}},
+				quiet:  []string{out.Value},
+			})
 		},
-	}
+	}, "Print only the value")
 }
 
 func GetCmdNames(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command {
+	return withOutputFlags(&cobra.Command {
 		Use: "names",
-		Short: "names",
+		Short: "Query every registered name",
+		Args: cobra.NoArgs,
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/names", queryRoute))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResNames
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return newPrinter(cmd, cliCtx).print(namesOutput(out))
+		},
+	}, "Print only the names, one per line")
+}
+
+func GetCmdRecords(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "records [name]",
+		Short: "Query the typed records of name",
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/records/%s", queryRoute, name))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResRecords
+			cdc.MustUnmarshalJSON(res, &out)
+
+			output := queryOutput{value: out, header: []string{"type", "value", "ttl"}}
+			for _, record := range out {
+				output.rows = append(output.rows, []string{record.Type, record.Value, strconv.FormatUint(uint64(record.TTL), 10)})
+				output.quiet = append(output.quiet, record.Value)
+			}
+
+			return newPrinter(cmd, cliCtx).print(output)
+		},
+	}, "Print only the record values, one per line")
+}
+
+func GetCmdAddress(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "address [name]",
+		Short: "Query the account coins sent to name are paid to",
 		Args: cobra.ExactArgs(1),
 		RunE: func (cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/names", queryRoute), nil)
-		
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/address/%s", queryRoute, name))
 			if err != nil {
-				fmt.Sprintf("Couldn't Get Query Names \n")
-				return nil
+				return err
 			}
 
-			var output types.QueryResNames
-			cdc.MustUnmarshalJSON(res, &output)
-			return cliCtx.PrintOutput(output)
+			var out types.QueryResAddress
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return newPrinter(cmd, cliCtx).print(queryOutput{
+				value:  out,
+				header: []string{"name", "address"},
+				rows:   [][]string{{name, out.Address.String()}},
+				quiet:  []string{out.Address.String()},
+			})
 		},
+	}, "Print only the address")
+}
+
+func GetCmdOwned(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "owned [address]",
+		Short: "Query the names owned by address",
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/owned/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResNames
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return newPrinter(cmd, cliCtx).print(namesOutput(out))
+		},
+	}, "Print only the names, one per line")
+}
+
+func GetCmdOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "operators [name]",
+		Short: "Query the operators approved to act on name",
+		Long: `Query the operators approved to act on name: those its owner approved on it,
+then those approved on all the owner's names. Expired approvals aren't listed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			name := args[0]
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/operators/%s", queryRoute, name))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResOperators
+			cdc.MustUnmarshalJSON(res, &out)
+
+			output := queryOutput{value: out, header: []string{"name", "operator", "scope", "expiry"}}
+			for _, approval := range out {
+				name, expiry := approval.Name, "never"
+				if name == "" {
+					name = "*"
+				}
+				if !approval.Expiry.IsZero() {
+					expiry = approval.Expiry.UTC().Format(time.RFC3339)
+				}
+
+				output.rows = append(output.rows, []string{name, approval.Operator.String(), approval.Scope, expiry})
+				output.quiet = append(output.quiet, approval.Operator.String())
+			}
+
+			return newPrinter(cmd, cliCtx).print(output)
+		},
+	}, "Print only the operator addresses, one per line")
+}
+
+func GetCmdFeeAllowances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "fee-allowances [grantee]",
+		Short: "Query the fee allowances granted to grantee",
+		Long: `Query the fee allowances granted to grantee, ordered by granter, with what is left of
+each and when it expires. Expired allowances are listed until their granter revokes them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/fee_allowances/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResFeeAllowances
+			cdc.MustUnmarshalJSON(res, &out)
+
+			output := queryOutput{value: out, header: []string{"granter", "remaining", "spend limit", "expiry"}}
+			for _, allowance := range out {
+				expiry := "never"
+				if !allowance.Expiry.IsZero() {
+					expiry = allowance.Expiry.UTC().Format(time.RFC3339)
+				}
+
+				spendLimit := "none"
+				if !allowance.SpendLimit.Empty() {
+					spendLimit = allowance.SpendLimit.String()
+				}
+
+				output.rows = append(output.rows, []string{allowance.Granter.String(), allowance.Remaining.String(), spendLimit, expiry})
+				output.quiet = append(output.quiet, allowance.Granter.String())
+			}
+
+			return newPrinter(cmd, cliCtx).print(output)
+		},
+	}, "Print only the granter addresses, one per line")
+}
+
+func GetCmdProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "proposals [name]",
+		Short: "Query the open proposals on a name with several owners",
+		Long: `Query the proposals on name that haven't expired, oldest first, with how many owners
+approved each. A passed sale stays listed until its buyer buys the name or it expires.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/proposals/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResProposals
+			cdc.MustUnmarshalJSON(res, &out)
+
+			output := queryOutput{value: out, header: []string{"id", "action", "proposer", "approvals", "status", "expiry"}}
+			for _, proposal := range out {
+				status := "open"
+				if proposal.Passed {
+					status = "passed"
+				}
+
+				id := strconv.FormatUint(proposal.ID, 10)
+				output.rows = append(output.rows, []string{
+					id, proposal.Action.String(), proposal.Proposer.String(),
+					strconv.Itoa(len(proposal.Approvals)), status, proposal.Expiry.UTC().Format(time.RFC3339),
+				})
+				output.quiet = append(output.quiet, id)
+			}
+
+			return newPrinter(cmd, cliCtx).print(output)
+		},
+	}, "Print only the proposal IDs, one per line")
+}
+
+func GetCmdGuardians(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "guardians [name]",
+		Short: "Query the guardians who can recover name",
+		Long: `Query the guardian set covering name: the one its owner gave it, else the one on all
+the owner's names. Names with several owners have none.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/guardians/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.GuardianSet
+			cdc.MustUnmarshalJSON(res, &out)
+
+			output := queryOutput{value: out, header: []string{"guardian", "threshold", "delay"}}
+			for _, guardian := range out.Guardians {
+				output.rows = append(output.rows, []string{guardian.String(), fmt.Sprintf("%d of %d", out.Threshold, len(out.Guardians)), out.Delay.String()})
+				output.quiet = append(output.quiet, guardian.String())
+			}
+
+			return newPrinter(cmd, cliCtx).print(output)
+		},
+	}, "Print only the guardian addresses, one per line")
+}
+
+func GetCmdRecoveries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "recoveries [name]",
+		Short: "Query the recoveries of name under way",
+		Long: `Query the moves of name to a new owner its guardians have started, with how many of
+them approved each and, once enough have, when it can be finalized.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/recoveries/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResRecoveries
+			cdc.MustUnmarshalJSON(res, &out)
+
+			output := queryOutput{value: out, header: []string{"new_owner", "approvals", "started", "ready_at"}}
+			for _, recovery := range out {
+				readyAt := ""
+				if recovery.Approved() {
+					readyAt = recovery.ReadyAt.UTC().Format(time.RFC3339)
+				}
+
+				output.rows = append(output.rows, []string{
+					recovery.NewOwner.String(), fmt.Sprintf("%d of %d", len(recovery.Approvals), recovery.Threshold),
+					recovery.Started.UTC().Format(time.RFC3339), readyAt,
+				})
+				output.quiet = append(output.quiet, recovery.NewOwner.String())
+			}
+
+			return newPrinter(cmd, cliCtx).print(output)
+		},
+	}, "Print only the new owners, one per line")
+}
+
+func GetCmdLeases(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "leases [name]",
+		Short: "Query the leases of name and the lease offers outstanding",
+		Long: `Query the leases name is under, its owner's first and then each sublease of the one
+before it, the last lessee controlling its value and records, followed by the offers not yet
+accepted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/leases/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResLeases
+			cdc.MustUnmarshalJSON(res, &out)
+
+			output := queryOutput{value: out, header: []string{"lessor", "lessee", "fee", "period", "end", "escrow"}}
+			for _, lease := range out {
+				end := "offered"
+				if lease.Accepted() {
+					end = fmt.Sprintf("%d", lease.End)
+				}
+
+				output.rows = append(output.rows, []string{
+					lease.Lessor.String(), lease.Lessee.String(), lease.Fee.String(),
+					fmt.Sprintf("%d", lease.Period), end, lease.Escrow.String(),
+				})
+				output.quiet = append(output.quiet, lease.Lessee.String())
+			}
+
+			return newPrinter(cmd, cliCtx).print(output)
+		},
+	}, "Print only the lessees, one per line")
+}
+
+func GetCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "params",
+		Short: "Query the nameservice parameters",
+		Args: cobra.NoArgs,
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/params", queryRoute))
+			if err != nil {
+				return err
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return newPrinter(cmd, cliCtx).print(queryOutput{
+				value:  out,
+				header: []string{
+					"harberger_default", "tax_rate", "tax_period", "auction_blocks", "auction_start_multiple",
+					"auction_bid_blocks", "auction_extend_window", "auction_extend_blocks", "auction_max_extension",
+					"delete_refund_rate", "delete_cooldown_blocks",
+				},
+				rows: [][]string{{
+					fmt.Sprintf("%t", out.HarbergerDefault), out.TaxRate.String(), fmt.Sprintf("%d", out.TaxPeriod),
+					fmt.Sprintf("%d", out.AuctionBlocks), out.AuctionStartMultiple.String(),
+					fmt.Sprintf("%d", out.AuctionBidBlocks), fmt.Sprintf("%d", out.AuctionExtendWindow),
+					fmt.Sprintf("%d", out.AuctionExtendBlocks), fmt.Sprintf("%d", out.AuctionMaxExtension),
+					out.DeleteRefundRate.String(), fmt.Sprintf("%d", out.DeleteCooldownBlocks),
+				}},
+				quiet:  []string{out.TaxRate.String()},
+			})
+		},
+	}, "Print only the tax rate")
+}
+
+func GetCmdAuction(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "auction [name]",
+		Short: "Query the auction of a released name and its current price",
+		Long: `Query the Dutch auction of name, released back into circulation. Its price falls every
+block from the start price to the minimum name price at the end height. The first buy at the
+current price takes it or, when the params open a bidding window, becomes the leading bid until
+bidding closes. Bids late in the window push the close back, shown as the blocks extended.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/auction/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResAuction
+			cdc.MustUnmarshalJSON(res, &out)
+
+			return newPrinter(cmd, cliCtx).print(queryOutput{
+				value:  out,
+				header: []string{"name", "start_price", "start", "end", "price", "bidder", "close", "extended"},
+				rows: [][]string{{
+					out.Auction.Name, out.Auction.StartPrice.String(), fmt.Sprintf("%d", out.Auction.Start),
+					fmt.Sprintf("%d", out.Auction.End), out.Price.String(), out.Auction.Bidder.String(),
+					fmt.Sprintf("%d", out.Auction.Close), fmt.Sprintf("%d", out.Auction.Extended),
+				}},
+				quiet:  []string{out.Price.String()},
+			})
+		},
+	}, "Print only the current price")
+}
+
+func GetCmdStatus(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return withOutputFlags(&cobra.Command {
+		Use: "status [name]",
+		Short: "Query where name stands in its lifecycle",
+		Long: `Query whether name is available, registered, leased, auctioned, open for bidding or
+cooling down after its owners deleted it, along with what it takes to get it now.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/status/%s", queryRoute, args[0]))
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResStatus
+			cdc.MustUnmarshalJSON(res, &out)
+
+			// The Height The Current Status Lasts Until, Where It Has One
+			var owner, until string
+			switch {
+			case out.WhoIs != nil:
+				owner = ownersCell(*out.WhoIs)
+				if len(out.Leases) != 0 {
+					until = fmt.Sprintf("%d", out.Leases[0].End)
+				}
+			case out.Auction != nil:
+				owner = out.Auction.Bidder.String()
+				until = fmt.Sprintf("%d", out.Auction.Closes())
+			case out.Cooldown != nil:
+				owners := make([]string, len(out.Cooldown.Owners))
+				for i, o := range out.Cooldown.Owners {
+					owners[i] = o.String()
+				}
+				owner = strings.Join(owners, ",")
+				until = fmt.Sprintf("%d", out.Cooldown.Until)
+			}
+
+			return newPrinter(cmd, cliCtx).print(queryOutput{
+				value:  out,
+				header: []string{"name", "status", "price", "owner", "until"},
+				rows:   [][]string{{out.Name, out.Status, out.Price.String(), owner, until}},
+				quiet:  []string{out.Status},
+			})
+		},
+	}, "Print only the status")
+}
+
+// ownersCell is a name's owner, or its owners and how many must approve
+func ownersCell(whois types.WhoIs) string {
+	if !whois.IsMultiOwner() {
+		return whois.Owner.String()
+	}
+
+	owners := make([]string, len(whois.Owners))
+	for i, owner := range whois.Owners {
+		owners[i] = owner.String()
 	}
-}
\ No newline at end of file
+	return fmt.Sprintf("%s (%d of %d)", strings.Join(owners, ","), whois.Threshold, len(whois.Owners))
+}
+
+func namesOutput(names types.QueryResNames) queryOutput {
+	output := queryOutput{value: names, header: []string{"name"}, quiet: names}
+	for _, name := range names {
+		output.rows = append(output.rows, []string{name})
+	}
+	return output
+}
+
+func GetCmdWatch(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command {
+		Use: "watch",
+		Short: "Stream name changes as they are committed",
+		Long: `Print every name registered, sold, changed or deleted from now on, until
+interrupted. --name and --owner limit the stream to one name or to the names an
+address gains or loses.`,
+		Args: cobra.NoArgs,
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			filter := watch.Filter{Name: viper.GetString(flagName), Owner: viper.GetString(flagOwner)}
+			if filter.Owner != "" {
+				if _, err := sdk.AccAddressFromBech32(filter.Owner); err != nil {
+					return err
+				}
+			}
+
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			// Subscriptions Run Over The Websocket, Which Starting The Client Opens
+			if err := node.Start(); err != nil {
+				return err
+			}
+			defer node.Stop() // nolint: errcheck
+
+			ctx, cancel := stdcontext.WithCancel(stdcontext.Background())
+			defer cancel()
+
+			go func() {
+				sigs := make(chan os.Signal, 1)
+				signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+				<-sigs
+				cancel()
+			}()
+
+			p := newPrinter(cmd, cliCtx)
+			return watch.Stream(ctx, node, filter, func(event watch.Event) error {
+				return p.print(queryOutput{
+					value:  event,
+					header: []string{"height", "type", "name", "owner", "previous_owner", "value", "price", "txhash"},
+					rows:   [][]string{{strconv.FormatInt(event.Height, 10), event.Type, event.Name, event.Owner, event.PreviousOwner, event.Value, event.Price, event.TxHash}},
+					quiet:  []string{event.Name},
+				})
+			})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Only show changes to this name")
+	cmd.Flags().String(flagOwner, "", "Only show changes to names this address owns or owned")
+
+	return withOutputFlags(cmd, "Print only the names that change, one per line")
+}
+
+func GetCmdExportZone(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command {
+		Use: "export-zone",
+		Short: "Export every name and its records as an RFC 1035 zone file",
+		Long: `Export every name and its records as an RFC 1035 zone file. Names without typed
+records are exported with the record derived from their value, as served by dns-server.
+The SOA serial is the height the names were read at.`,
+		Args: cobra.NoArgs,
+		RunE: func (cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, height, err := query(cliCtx, fmt.Sprintf("custom/%s/names", queryRoute))
+			if err != nil {
+				return err
+			}
+
+			var names types.QueryResNames
+			cdc.MustUnmarshalJSON(res, &names)
+
+			// Pin Every whoIs To The Height The Names Were Listed At
+			cliCtx = cliCtx.WithHeight(height)
+
+			entries := make([]dns.ZoneEntry, 0, len(names))
+			for _, name := range names {
+				res, _, err := query(cliCtx, fmt.Sprintf("custom/%s/whois/%s", queryRoute, name))
+				if err != nil {
+					return err
+				}
+
+				var whois types.WhoIs
+				cdc.MustUnmarshalJSON(res, &whois)
+
+				records := whois.Records
+				if len(records) == 0 {
+					records = []types.Record{types.RecordFromValue(whois.Value)}
+				}
+
+				entries = append(entries, dns.ZoneEntry{Name: name, Records: records})
+			}
+
+			out := cmd.OutOrStdout()
+			if path := viper.GetString(flagOut); path != "" {
+				file, err := os.Create(path)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			return dns.WriteZone(out, viper.GetString(flagZone), height, entries)
+		},
+	}
+
+	cmd.Flags().String(flagZone, dns.DefaultZone, "The zone names are exported under")
+	cmd.Flags().String(flagOut, "", "Write the zone file here instead of stdout")
+
+	return cmd
+}
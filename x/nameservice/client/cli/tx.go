@@ -3,8 +3,12 @@ package cli
 import (
 	"fmt"
 	"bufio"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -13,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
 	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
 
 )
@@ -34,11 +39,42 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdBuyName(cdc),
 		GetCmdSetName(cdc),
 		GetCmdDeleteName(cdc),
+		GetCmdSetRecords(cdc),
+		GetCmdSendToName(cdc),
+		GetCmdBatch(cdc),
+		GetCmdApproveOperator(cdc),
+		GetCmdRevokeOperator(cdc),
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+		GetCmdSetOwners(cdc),
+		GetCmdPropose(cdc),
+		GetCmdApproveProposal(cdc),
+		GetCmdSetGuardians(cdc),
+		GetCmdClearGuardians(cdc),
+		GetCmdStartRecovery(cdc),
+		GetCmdApproveRecovery(cdc),
+		GetCmdVetoRecovery(cdc),
+		GetCmdFinalizeRecovery(cdc),
+		GetCmdOfferLease(cdc),
+		GetCmdAcceptLease(cdc),
+		GetCmdEndLease(cdc),
+		GetCmdSelfAssess(cdc),
+		GetCmdDepositTax(cdc),
+		GetCmdWithdrawTax(cdc),
 	)...)
 
 	return nameserviceTxCmd
 }
 
+const (
+	flagTTL = "ttl"
+	flagExpires = "expires"
+	flagSpendLimit = "spend-limit"
+	flagThreshold = "threshold"
+	flagDelay = "delay"
+	flagPeriod = "period"
+)
+
 // Define cobra.Commands For Each Module's Added Transaction Command
 
 func GetCmdBuyName(cdc *codec.Codec) *cobra.Command {
@@ -102,6 +138,9 @@ func GetCmdDeleteName(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "delete-name [name]",
 		Short: "Delete The Name That You Own (along with it's associated fields)",
+		Long: `Delete name, refunding you the share of its registration price the params set, with the
+rest going to the community pool. For the cooldown the params set, only you can register the
+name again. Query its status to see when the cooldown ends.`,
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -121,3 +160,810 @@ func GetCmdDeleteName(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func GetCmdSetRecords(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-records [name] [type=value]...",
+		Short: "Replace The Typed Records (A, AAAA, TXT, CNAME, ADDR) Of Your Name",
+		Long: `Replace the typed records of a name you own. Each record is given as TYPE=VALUE,
+e.g. "A=10.0.0.1 TXT=hello". An ADDR record holds the account coins sent to the name
+are paid to. Passing no records clears them.`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			ttl := uint32(viper.GetUint(flagTTL))
+
+			var records []types.Record
+			for _, arg := range args[1:] {
+				parts := strings.SplitN(arg, "=", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid record %q, expected TYPE=VALUE", arg)
+				}
+
+				records = append(records, types.NewRecord(parts[0], parts[1], ttl))
+			}
+
+			msg := types.NewMsgSetRecords(args[0], records, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint(flagTTL, uint(types.DefaultRecordTTL), "TTL (seconds) applied to every record")
+
+	return cmd
+}
+
+func GetCmdSendToName(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "send [name] [amount]",
+		Short: "Send Coins To The Account A Name Resolves To",
+		Long: `Send coins to a name. The recipient is looked up when the transaction executes:
+the account in the name's ADDR record if it has one, otherwise the name's owner. The
+name may be given with or without its zone, e.g. alice or alice.ns.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			name := strings.TrimSuffix(strings.TrimSuffix(args[0], "."), "."+dns.DefaultZone)
+			msg := types.NewMsgSendToName(name, coins, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdApproveOperator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-operator [operator] [scope]",
+		Short: "Let An Operator Manage Your Names' Records (records) Or Everything But Their Ownership (manage)",
+		Long: `Approve an operator address to act on your names within a scope: "records" lets it
+replace their typed records except ADDR records, which decide where coins sent to the
+name are paid; "manage" also lets it change those and set their values. Neither lets
+it sell, transfer or delete them. The approval covers every name you own, or just the one
+given with --name, until revoked or until --expires. Approving the same operator again
+replaces its approval.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			expiry, err := parseExpiry(viper.GetString(flagExpires))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveOperator(viper.GetString(flagName), operator, args[1], expiry, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Approve the operator on this name only, rather than all your names")
+	cmd.Flags().String(flagExpires, "", "When the approval lapses, as an RFC 3339 time or a duration from now like 720h (default never)")
+
+	return cmd
+}
+
+func GetCmdRevokeOperator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-operator [operator]",
+		Short: "Revoke An Operator's Approval On All Your Names, Or On The One Given With --name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			operator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeOperator(viper.GetString(flagName), operator, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Revoke the approval given on this name, rather than on all your names")
+
+	return cmd
+}
+
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee-allowance [grantee] [amount]",
+		Short: "Set Aside Coins To Pay The Fees Of A Grantee's Nameservice Txs",
+		Long: `Move amount from your account into the nameservice module account, to pay the fees
+of grantee's txs made up only of nameservice msgs, like setting the records of names
+you approved it to operate. A tx whose fee is over --spend-limit isn't paid for, so
+no one tx can spend the whole allowance. Granting to the same grantee again tops up
+its allowance and replaces its spend limit and expiry with --spend-limit and --expires.
+Revoking the allowance refunds what is left.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			expiry, err := parseExpiry(viper.GetString(flagExpires))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(grantee, amount, spendLimit, expiry, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagSpendLimit, "", "The most the fee of any one tx can take from the allowance (default no limit)")
+	cmd.Flags().String(flagExpires, "", "When the allowance stops paying fees, as an RFC 3339 time or a duration from now like 720h (default never)")
+
+	return cmd
+}
+
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-fee-allowance [grantee]",
+		Short: "Revoke The Fee Allowance You Granted, Refunding What Is Left Of It",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(grantee, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetOwners(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-owners [name] [owner,...]",
+		Short: "Hand A Name To A Set Of Owners, --threshold Of Whom Must Approve What Is Done To It",
+		Long: `Hand a name to a comma separated list of owners. Once it has several owners, changing
+its value, transferring, selling or deleting it is proposed by one of them and carried out
+when --threshold of them have approved. The first owner is paid for the name's sale and
+for coins sent to it. Pending proposals and operator approvals on the name are dropped.
+
+Only the name's owner can do this, or any of its owners if one is enough. Otherwise a
+change of owners is proposed with "propose [name] transfer".`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owners, err := parseAddresses(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetOwners(args[0], owners, uint32(viper.GetUint(flagThreshold)), cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint(flagThreshold, 1, "How many of the owners must approve a proposal")
+
+	return cmd
+}
+
+func GetCmdPropose(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose [name] [action] [args...]",
+		Short: "Propose An Action On A Name With Several Owners, Approving It Yourself",
+		Long: `Propose an action on a name you own with others. It is carried out once the name's
+threshold of owners have approved it, your proposal counting as your approval:
+
+	propose [name] set-value [value]
+	propose [name] transfer [owner,...] --threshold n
+	propose [name] sell [buyer] [price]
+	propose [name] delete
+
+A passed sale lets the buyer buy the name with buy-name, bidding at least the price,
+until the proposal expires. Proposals expire after --expires, a week by default.`,
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			action, err := parseProposalAction(args[1], args[2:], uint32(viper.GetUint(flagThreshold)))
+			if err != nil {
+				return err
+			}
+
+			expiry, err := parseExpiry(viper.GetString(flagExpires))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPropose(args[0], action, expiry, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Uint(flagThreshold, 1, "How many of the new owners must approve a proposal, for transfer")
+	cmd.Flags().String(flagExpires, "", "When the proposal lapses, as an RFC 3339 time or a duration from now like 72h (default a week)")
+
+	return cmd
+}
+
+func GetCmdApproveProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-proposal [name] [id]",
+		Short: "Approve A Proposal On A Name You Own With Others, Carrying It Out If Enough Owners Have",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id %q", args[1])
+			}
+
+			msg := types.NewMsgApproveProposal(args[0], id, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSetGuardians(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-guardians [guardian,...]",
+		Short: "Let --threshold Guardians Recover Your Names, Or The One Given With --name, If You Lose Your Key",
+		Long: `Register a comma separated list of guardians for the names you own alone, or just the
+one given with --name, which takes precedence. If --threshold of them agree to move a name
+to a new owner, it moves --delay later unless you veto it with veto-recovery first.
+Setting guardians again replaces them; recoveries already started keep theirs.`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			guardians, err := parseAddresses(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetGuardians(viper.GetString(flagName), guardians, uint32(viper.GetUint(flagThreshold)),
+				viper.GetDuration(flagDelay), cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Guard this name only, rather than all your names")
+	cmd.Flags().Uint(flagThreshold, 0, "How many of the guardians must agree to a recovery")
+	cmd.Flags().Duration(flagDelay, 3*types.MinRecoveryDelay, "How long you have to veto a recovery once the guardians agree")
+	cmd.MarkFlagRequired(flagThreshold)
+
+	return cmd
+}
+
+func GetCmdClearGuardians(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-guardians",
+		Short: "Remove The Guardians Of All Your Names, Or Of The One Given With --name",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgSetGuardians(viper.GetString(flagName), nil, 0, 0, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagName, "", "Remove the guardians of this name, rather than of all your names")
+
+	return cmd
+}
+
+func GetCmdStartRecovery(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "start-recovery [name] [new-owner]",
+		Short: "As A Guardian, Start Moving A Name To A New Owner",
+		Long: `As one of a name's guardians, start moving it to a new owner, approving the move
+yourself. Once the name's threshold of guardians approve, its owner has the guardians'
+delay to veto the move before finalize-recovery completes it. A move that doesn't get
+enough approvals within a week lapses.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgStartRecovery(args[0], newOwner, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdApproveRecovery(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "approve-recovery [name] [new-owner]",
+		Short: "As A Guardian, Approve Moving A Name To A New Owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveRecovery(args[0], newOwner, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdVetoRecovery(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "veto-recovery [name] [new-owner]",
+		Short: "Cancel The Recovery Of Your Name To new-owner, Or Every Recovery Of It",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var newOwner sdk.AccAddress
+			if len(args) == 2 {
+				var err error
+				if newOwner, err = sdk.AccAddressFromBech32(args[1]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgVetoRecovery(args[0], newOwner, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdFinalizeRecovery(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "finalize-recovery [name] [new-owner]",
+		Short: "Complete A Recovery Whose Veto Delay Is Over, Handing The Name To Its New Owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFinalizeRecovery(args[0], newOwner, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// parseProposalAction reads the action and arguments given to propose
+func GetCmdOfferLease(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "offer-lease [name] [lessee] [blocks] [fee]",
+		Short: "Offer Control Of A Name's Value And Records To A Lessee For A Number Of Blocks",
+		Long: `Offer lessee control of name's value and records for blocks blocks, from when it
+accepts. As the owner you lease the name; as its lessee you sublet it, for no longer than
+your own lease. The lessee pays fee up front, or with --period every that many blocks,
+escrowing the later periods when it accepts. It can't transfer, sell or delete the name,
+and neither can its owners until the lease ends. Offering again replaces your offer.`,
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			lessee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			blocks, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number of blocks %q: %w", args[2], err)
+			}
+
+			fee, err := sdk.ParseCoins(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgOfferLease(args[0], lessee, blocks, fee, viper.GetInt64(flagPeriod), cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(flagPeriod, 0, "Charge fee every this many blocks, which must divide the lease (default once, up front)")
+
+	return cmd
+}
+
+func GetCmdAcceptLease(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept-lease [name] [lessor]",
+		Short: "Take Up The Lease Of A Name Lessor Offered You, Paying Its Fee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			lessor, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptLease(args[0], lessor, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdEndLease(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "end-lease [name] [lessor]",
+		Short: "Withdraw Or Decline A Lease Offer, Or Give Up Your Lease Early",
+		Long: `Call off the lease of name lessor offered, as either side of the offer, or as the
+lessee end a lease you accepted early. Ending a lease early ends its subleases and refunds
+the periods still escrowed; what was already paid isn't refunded.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			lessor, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEndLease(args[0], lessor, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdSelfAssess(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "self-assess [name] [price]",
+		Short: "Declare The Price Anyone May Buy Your Name At, Putting It Under Harberger Tax",
+		Long: `Declare the price name sells at to whoever bids it. The first declaration puts name under
+Harberger tax for good: every tax period it pays the tax rate on its declared price into the
+community pool out of its deposit, and is foreclosed once the deposit runs short.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSelfAssess(args[0], coins, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdDepositTax(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-tax [name] [amount]",
+		Short: "Add To The Deposit A Harberger Name Pays Its Tax From",
+		Long: `Add amount to the deposit name pays its tax from. Anyone may deposit, but only the owner
+can withdraw, and what is left is refunded to the owner when the name is sold or deleted.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositTax(args[0], coins, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func GetCmdWithdrawTax(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-tax [name] [amount]",
+		Short: "Withdraw From Your Name's Tax Deposit",
+		Long: `Withdraw amount from the deposit name pays its tax from, once the tax owed so far is paid.`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTax(args[0], coins, cliCtx.GetFromAddress())
+
+			// State-less Checks
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func parseProposalAction(actionType string, args []string, threshold uint32) (types.ProposalAction, error) {
+	action := types.ProposalAction{Type: actionType}
+
+	switch actionType {
+	case types.ActionSetValue:
+		if len(args) != 1 {
+			return action, fmt.Errorf("%s takes a value", actionType)
+		}
+		action.Value = args[0]
+	case types.ActionTransfer:
+		if len(args) != 1 {
+			return action, fmt.Errorf("%s takes a comma separated list of owners", actionType)
+		}
+
+		owners, err := parseAddresses(args[0])
+		if err != nil {
+			return action, err
+		}
+		action.Owners, action.Threshold = owners, threshold
+	case types.ActionSell:
+		if len(args) != 2 {
+			return action, fmt.Errorf("%s takes a buyer and a price", actionType)
+		}
+
+		buyer, err := sdk.AccAddressFromBech32(args[0])
+		if err != nil {
+			return action, err
+		}
+
+		price, err := sdk.ParseCoins(args[1])
+		if err != nil {
+			return action, err
+		}
+		action.Buyer, action.Price = buyer, price
+	case types.ActionDelete:
+		if len(args) != 0 {
+			return action, fmt.Errorf("%s takes no arguments", actionType)
+		}
+	default:
+		return action, fmt.Errorf("unknown action %q, expected %s, %s, %s or %s",
+			actionType, types.ActionSetValue, types.ActionTransfer, types.ActionSell, types.ActionDelete)
+	}
+
+	return action, nil
+}
+
+// parseAddresses reads a comma separated list of addresses
+func parseAddresses(list string) ([]sdk.AccAddress, error) {
+	var addrs []sdk.AccAddress
+	for _, s := range strings.Split(list, ",") {
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("invalid address %q: %w", s, err)
+		}
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// parseExpiry reads an RFC 3339 time, or a duration counted from now. An empty
+// string never expires.
+func parseExpiry(expires string) (time.Time, error) {
+	if expires == "" {
+		return time.Time{}, nil
+	}
+
+	if d, err := time.ParseDuration(expires); err == nil {
+		return time.Now().Add(d).UTC().Truncate(time.Second), nil
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %q, expected an RFC 3339 time or a duration", expires)
+	}
+	return expiry.UTC(), nil
+}
@@ -0,0 +1,275 @@
+// Package client is a typed Go client for the nameservice module. It wraps the
+// module's custom queries so services don't have to build query paths or
+// decode amino themselves, and returns the module's registered errors so they
+// can be matched with errors.Is.
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/keeper"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+const (
+	// DefaultRetries is how many times a query is retried after a transport error
+	DefaultRetries = 3
+
+	// DefaultBackoff is the wait before the first retry, doubled on each one after
+	DefaultBackoff = 500 * time.Millisecond
+)
+
+// Client reads names from the nameservice module
+type Client interface {
+	// Resolve returns the value name resolves to
+	Resolve(ctx context.Context, name string) (string, error)
+
+	// WhoIs returns everything stored for name
+	WhoIs(ctx context.Context, name string) (types.WhoIs, error)
+
+	// Records returns the typed records of name
+	Records(ctx context.Context, name string) ([]types.Record, error)
+
+	// Address returns the account coins sent to name are paid to
+	Address(ctx context.Context, name string) (sdk.AccAddress, error)
+
+	// Names returns every registered name
+	Names(ctx context.Context) ([]string, error)
+
+	// Owned returns the names owned by owner
+	Owned(ctx context.Context, owner sdk.AccAddress) ([]string, error)
+}
+
+var _ Client = (*NodeClient)(nil)
+
+// NodeClient is a Client querying a node over its RPC interface
+type NodeClient struct {
+	node       rpcclient.Client
+	queryRoute string
+	height     int64
+	retries    int
+	backoff    time.Duration
+}
+
+// NewClient creates a NodeClient querying node at its latest height
+func NewClient(node rpcclient.Client) *NodeClient {
+	return &NodeClient{
+		node:       node,
+		queryRoute: types.QuerierRoute,
+		retries:    DefaultRetries,
+		backoff:    DefaultBackoff,
+	}
+}
+
+// NewClientFromURL creates a NodeClient for the node listening at url, e.g.
+// tcp://localhost:26657
+func NewClientFromURL(url string) (*NodeClient, error) {
+	node, err := rpcclient.NewHTTP(url, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(node), nil
+}
+
+// NewClientFromCLIContext creates a NodeClient using the node and height of
+// cliCtx
+func NewClientFromCLIContext(cliCtx clicontext.CLIContext) (*NodeClient, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(node).WithHeight(cliCtx.Height), nil
+}
+
+// WithHeight returns a copy of the client querying at height, 0 being the
+// latest
+func (c *NodeClient) WithHeight(height int64) *NodeClient {
+	client := *c
+	client.height = height
+	return &client
+}
+
+// WithRetries returns a copy of the client retrying transport errors up to
+// retries times, waiting backoff before the first retry
+func (c *NodeClient) WithRetries(retries int, backoff time.Duration) *NodeClient {
+	client := *c
+	client.retries = retries
+	client.backoff = backoff
+	return &client
+}
+
+// WithQueryRoute returns a copy of the client for a module mounted under a
+// different querier route
+func (c *NodeClient) WithQueryRoute(queryRoute string) *NodeClient {
+	client := *c
+	client.queryRoute = queryRoute
+	return &client
+}
+
+// Resolve implements Client
+func (c *NodeClient) Resolve(ctx context.Context, name string) (string, error) {
+	var out types.QueryResResolve
+	if err := c.queryJSON(ctx, &out, keeper.QueryResolve, name); err != nil {
+		return "", err
+	}
+
+	return out.Value, nil
+}
+
+// WhoIs implements Client
+func (c *NodeClient) WhoIs(ctx context.Context, name string) (types.WhoIs, error) {
+	var out types.WhoIs
+	if err := c.queryJSON(ctx, &out, keeper.QueryWhoIs, name); err != nil {
+		return types.WhoIs{}, err
+	}
+
+	// The whois Query Answers Unknown Names With An Unowned Default
+	if out.Owner.Empty() {
+		return types.WhoIs{}, sdkerrors.Wrap(types.ErrNameDoesNotExist, name)
+	}
+
+	return out, nil
+}
+
+// Records implements Client
+func (c *NodeClient) Records(ctx context.Context, name string) ([]types.Record, error) {
+	var out types.QueryResRecords
+	if err := c.queryJSON(ctx, &out, keeper.QueryRecords, name); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Address implements Client
+func (c *NodeClient) Address(ctx context.Context, name string) (sdk.AccAddress, error) {
+	var out types.QueryResAddress
+	if err := c.queryJSON(ctx, &out, keeper.QueryAddress, name); err != nil {
+		return nil, err
+	}
+
+	return out.Address, nil
+}
+
+// Names implements Client
+func (c *NodeClient) Names(ctx context.Context) ([]string, error) {
+	var out types.QueryResNames
+	if err := c.queryJSON(ctx, &out, keeper.QueryNames); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Owned implements Client
+func (c *NodeClient) Owned(ctx context.Context, owner sdk.AccAddress) ([]string, error) {
+	var out types.QueryResNames
+	if err := c.queryJSON(ctx, &out, keeper.QueryOwned, owner.String()); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// OwnedBy returns the names owned by each of owners, keyed by address, in a
+// single query. It isn't part of Client; batching callers such as the GraphQL
+// loaders use it when their backend has it.
+func (c *NodeClient) OwnedBy(ctx context.Context, owners []sdk.AccAddress) (map[string][]string, error) {
+	addrs := make([]string, len(owners))
+	for i, owner := range owners {
+		addrs[i] = owner.String()
+	}
+
+	var out types.QueryResOwnedBy
+	if err := c.queryJSON(ctx, &out, keeper.QueryOwnedBy, strings.Join(addrs, ",")); err != nil {
+		return nil, err
+	}
+
+	ownedBy := make(map[string][]string, len(out))
+	for _, owned := range out {
+		ownedBy[owned.Owner.String()] = owned.Names
+	}
+
+	return ownedBy, nil
+}
+
+// queryJSON runs the custom query endpoint/args... and decodes its result
+// into ptr
+func (c *NodeClient) queryJSON(ctx context.Context, ptr interface{}, endpoint string, args ...string) error {
+	path := fmt.Sprintf("custom/%s/%s", c.queryRoute, endpoint)
+	for _, arg := range args {
+		path += "/" + arg
+	}
+
+	res, err := c.query(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	return types.ModuleCdc.UnmarshalJSON(res, ptr)
+}
+
+// query runs an ABCI query, retrying transport errors with exponential
+// backoff. Errors returned by the app are not retried.
+func (c *NodeClient) query(ctx context.Context, path string) ([]byte, error) {
+	var err error
+
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(c.backoff << uint(attempt-1)):
+			}
+		}
+
+		var result *ctypes.ResultABCIQuery
+		result, err = c.abciQuery(ctx, path)
+		if err != nil {
+			continue
+		}
+
+		resp := result.Response
+		if !resp.IsOK() {
+			return nil, sdkerrors.ABCIError(resp.Codespace, resp.Code, resp.Log)
+		}
+
+		return resp.Value, nil
+	}
+
+	return nil, err
+}
+
+// abciQuery runs a single query, giving up when ctx is done. The RPC client
+// takes no context, so an abandoned query finishes in the background.
+func (c *NodeClient) abciQuery(ctx context.Context, path string) (*ctypes.ResultABCIQuery, error) {
+	type response struct {
+		result *ctypes.ResultABCIQuery
+		err    error
+	}
+
+	done := make(chan response, 1)
+	go func() {
+		result, err := c.node.ABCIQueryWithOptions(path, nil, rpcclient.ABCIQueryOptions{Height: c.height})
+		done <- response{result, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-done:
+		return res.result, res.err
+	}
+}
@@ -0,0 +1,98 @@
+package client_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+func TestNodeClientAgainstNode(t *testing.T) {
+	// A Name Someone Else Owns From Genesis
+	genState := nameservice.DefaultGenesisState()
+	other := sdk.AccAddress("other-owner-address-")
+	genState.WhoIsRecords = []nameservice.GenesisWhoIs{{
+		Name:  "taken",
+		WhoIs: nameservice.WhoIs{Value: "10.0.0.9", Owner: other, Price: sdk.NewCoins(sdk.NewInt64Coin("nametoken", 1))},
+	}}
+
+	node, err := app.StartTestNode(genState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client := nsclient.NewClient(node.Client).WithQueryRoute(nameservice.StoreKey).WithRetries(0, 0)
+
+	if _, err := client.WhoIs(ctx, "alice"); !nsclient.ErrNameDoesNotExist.Is(err) {
+		t.Fatalf("got %v for an unregistered name, want ErrNameDoesNotExist", err)
+	}
+
+	// Builders Run The Stateless Checks, So Bad Msgs Never Reach The Node
+	if _, err := nsclient.BuyName("Not A Name", sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10)), node.Account); !nsclient.ErrInvalidName.Is(err) {
+		t.Fatalf("got %v building a buy of an invalid name, want ErrInvalidName", err)
+	}
+	if _, err := nsclient.SetRecords("alice", []types.Record{{Type: "MX", Value: "mail", TTL: 60}}, node.Account); !nsclient.ErrInvalidRecord.Is(err) {
+		t.Fatalf("got %v building an invalid record, want ErrInvalidRecord", err)
+	}
+
+	buy, err := nsclient.BuyName("alice", sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10)), node.Account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := nsclient.SetRecords("alice", []types.Record{
+		{Type: types.RecordTypeA, Value: "10.0.0.1", TTL: 60},
+		{Type: types.RecordTypeADDR, Value: other.String(), TTL: 60},
+	}, node.Account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Deliver(buy, records); err != nil {
+		t.Fatal(err)
+	}
+
+	whois, err := client.WhoIs(ctx, "alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !whois.Owner.Equals(node.Account) || len(whois.Records) != 2 {
+		t.Errorf("alice is %+v, want the node's account's with two records", whois)
+	}
+
+	if addr, err := client.Address(ctx, "alice"); err != nil || !addr.Equals(other) {
+		t.Errorf("alice pays %s (%v), want its ADDR record %s", addr, err, other)
+	}
+
+	owned, err := client.OwnedBy(ctx, []sdk.AccAddress{node.Account, other})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := owned[node.Account.String()]; len(got) != 1 || got[0] != "alice" {
+		t.Errorf("the node's account owns %v, want alice", got)
+	}
+	if got := owned[other.String()]; len(got) != 1 || got[0] != "taken" {
+		t.Errorf("the other account owns %v, want taken", got)
+	}
+
+	// Someone Else's Name Can't Be Changed, And Is Left As It Was
+	set, err := nsclient.SetName("taken", "10.0.0.1", node.Account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Deliver(set); err == nil || !strings.Contains(err.Error(), "unauthorized") {
+		t.Fatalf("got %v setting someone else's name, want unauthorized", err)
+	}
+	if value, err := client.Resolve(ctx, "taken"); err != nil || value != "10.0.0.9" {
+		t.Errorf("taken resolves to %q (%v), want its genesis value", value, err)
+	}
+}
@@ -0,0 +1,79 @@
+package dns
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/resolver"
+)
+
+const (
+	flagListenAddr = "laddr"
+	flagZone       = "zone"
+	flagCacheSize  = "cache-size"
+	flagCacheTTL   = "cache-ttl"
+)
+
+// ServeCommand returns the dns-server command, which answers DNS queries for
+// names under a zone from the state of a node
+func ServeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dns-server",
+		Short: "Serve DNS (UDP & TCP) for nameservice names",
+		Long: `Answer DNS queries for <name>.<zone> from the nameservice records held by a node.
+A, AAAA, TXT and CNAME queries are answered from the name's typed records, or from
+its value when it has none. Answers are cached until a nameservice tx touching the
+name is committed, or for --cache-ttl while the node's event stream is unavailable.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := clicontext.NewCLIContext().WithCodec(cdc)
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "dns-server")
+
+			client, err := nsclient.NewClientFromCLIContext(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			res := resolver.New(client, viper.GetInt(flagCacheSize), viper.GetDuration(flagCacheTTL)).WithLogger(logger)
+			server := NewServer(res, viper.GetString(flagZone), logger)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			go func() {
+				sigs := make(chan os.Signal, 1)
+				signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+				<-sigs
+				cancel()
+			}()
+
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			go res.Watch(ctx, node)
+
+			return server.ListenAndServe(ctx, viper.GetString(flagListenAddr))
+		},
+	}
+
+	cmd.Flags().String(flagListenAddr, "0.0.0.0:53", "The address to listen on for UDP and TCP queries")
+	cmd.Flags().String(flagZone, DefaultZone, "The zone names are served under")
+	cmd.Flags().Int(flagCacheSize, resolver.DefaultSize, "Number of names to keep cached")
+	cmd.Flags().Duration(flagCacheTTL, resolver.DefaultTTL, "How long cached answers are trusted while node events are unavailable")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+
+	return cmd
+}
@@ -0,0 +1,426 @@
+package dns
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/resolver"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+const (
+	// Zone Names Are Served Under Unless Configured Otherwise
+	DefaultZone = "ns"
+
+	// TTL (Seconds) Resolvers May Cache Missing Names For, Per The Zone's SOA
+	DefaultNegativeTTL = 30
+
+	// SOA Timers (Seconds) For Secondaries, Which The Zone Doesn't Have But Must Still Advertise
+	soaRefresh = 3600
+	soaRetry   = 600
+	soaExpire  = 86400
+
+	// Longest Character String A TXT Record Can Hold (RFC 1035 3.3)
+	maxTXTString = 255
+
+	// Largest Response Sent Over UDP Before Setting The TC Bit
+	maxUDPSize = 512
+
+	// Longest A Single Question Waits On The Node
+	lookupTimeout = 5 * time.Second
+)
+
+// Server answers DNS queries for names under a zone (e.g. alice.ns.) from
+// the records held by the nameservice module
+type Server struct {
+	resolver *resolver.Resolver
+	zone     string
+	logger   log.Logger
+}
+
+// NewServer creates a Server that answers for names under zone through
+// resolver, which caches them and keeps them up to date
+func NewServer(resolver *resolver.Resolver, zone string, logger log.Logger) *Server {
+	return &Server{
+		resolver: resolver,
+		zone:     "." + strings.Trim(strings.ToLower(zone), ".") + ".",
+		logger:   logger,
+	}
+}
+
+// ListenAndServe answers queries on addr over both UDP and TCP until ctx is
+// cancelled or a listener fails
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	packetConn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	defer packetConn.Close()
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	s.logger.Info("Serving DNS", "addr", addr, "zone", s.zone)
+
+	errCh := make(chan error, 2)
+	go func() { errCh <- s.serveUDP(packetConn) }()
+	go func() { errCh <- s.serveTCP(listener) }()
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+func (s *Server) serveUDP(conn net.PacketConn) error {
+	buf := make([]byte, 65535)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return err
+		}
+
+		req := make([]byte, n)
+		copy(req, buf[:n])
+
+		go func() {
+			res, err := s.Handle(req, maxUDPSize)
+			if err != nil {
+				s.logger.Debug("Dropping DNS request", "from", addr, "err", err)
+				return
+			}
+
+			if _, err := conn.WriteTo(res, addr); err != nil {
+				s.logger.Error("Failed to write DNS response", "to", addr, "err", err)
+			}
+		}()
+	}
+}
+
+func (s *Server) serveTCP(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go s.serveTCPConn(conn)
+	}
+}
+
+// serveTCPConn answers length-prefixed requests (RFC 1035 4.2.2) until the
+// client closes the connection or goes idle
+func (s *Server) serveTCPConn(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		if err := conn.SetDeadline(time.Now().Add(10 * time.Second)); err != nil {
+			return
+		}
+
+		var size uint16
+		if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+			return
+		}
+
+		req := make([]byte, size)
+		if _, err := io.ReadFull(conn, req); err != nil {
+			return
+		}
+
+		res, err := s.Handle(req, 0)
+		if err != nil {
+			s.logger.Debug("Dropping DNS request", "from", conn.RemoteAddr(), "err", err)
+			return
+		}
+
+		if err := binary.Write(conn, binary.BigEndian, uint16(len(res))); err != nil {
+			return
+		}
+		if _, err := conn.Write(res); err != nil {
+			return
+		}
+	}
+}
+
+// Handle answers a single wire-format DNS request. Responses larger than
+// maxSize are replaced by an empty truncated response; a maxSize of zero
+// disables the limit.
+func (s *Server) Handle(req []byte, maxSize int) ([]byte, error) {
+	var p dnsmessage.Parser
+
+	header, err := p.Start(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Response {
+		return nil, fmt.Errorf("unexpected DNS response")
+	}
+
+	question, err := p.Question()
+	if err != nil {
+		return s.reply(header, nil, dnsmessage.RCodeFormatError, nil, maxSize)
+	}
+
+	if header.OpCode != 0 {
+		return s.reply(header, &question, dnsmessage.RCodeNotImplemented, nil, maxSize)
+	}
+
+	name, ok := s.nameFromQuestion(question)
+	if !ok {
+		return s.reply(header, &question, dnsmessage.RCodeRefused, nil, maxSize)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+
+	whois, err := s.resolver.WhoIs(ctx, name)
+	switch {
+	case types.ErrNameDoesNotExist.Is(err):
+		return s.reply(header, &question, dnsmessage.RCodeNameError, nil, maxSize)
+
+	case err != nil:
+		s.logger.Error("Failed to resolve name", "name", name, "err", err)
+		return s.reply(header, &question, dnsmessage.RCodeServerFailure, nil, maxSize)
+	}
+
+	return s.reply(header, &question, dnsmessage.RCodeSuccess, selectRecords(servedRecords(whois), question.Type), maxSize)
+}
+
+// nameFromQuestion maps a question for <name>.<zone>. onto a nameservice name
+func (s *Server) nameFromQuestion(q dnsmessage.Question) (string, bool) {
+	if q.Class != dnsmessage.ClassINET && q.Class != dnsmessage.ClassANY {
+		return "", false
+	}
+
+	fqdn := strings.ToLower(q.Name.String())
+	if !strings.HasSuffix(fqdn, s.zone) {
+		return "", false
+	}
+
+	name := strings.TrimSuffix(fqdn, s.zone)
+	if len(name) == 0 {
+		return "", false
+	}
+
+	return name, true
+}
+
+// servedRecords returns the typed records of a name, or for a name without
+// any the record derived from its value
+func servedRecords(whois types.WhoIs) []types.Record {
+	if len(whois.Records) == 0 && len(whois.Value) > 0 {
+		return []types.Record{types.RecordFromValue(whois.Value)}
+	}
+
+	return whois.Records
+}
+
+// selectRecords returns the records answering a question of type qtype. A
+// CNAME answers every type, as the resolver is expected to follow it.
+func selectRecords(records []types.Record, qtype dnsmessage.Type) []types.Record {
+	var cnames, matches []types.Record
+
+	for _, record := range records {
+		if record.Type == types.RecordTypeCNAME {
+			cnames = append(cnames, record)
+		}
+
+		// ADDR Records Are Nameservice Only, With No DNS Counterpart
+		if recordType(record.Type) == 0 {
+			continue
+		}
+
+		if qtype == dnsmessage.TypeALL || recordType(record.Type) == qtype {
+			matches = append(matches, record)
+		}
+	}
+
+	if len(matches) == 0 {
+		return cnames
+	}
+
+	return matches
+}
+
+func recordType(recordType string) dnsmessage.Type {
+	switch recordType {
+	case types.RecordTypeA:
+		return dnsmessage.TypeA
+	case types.RecordTypeAAAA:
+		return dnsmessage.TypeAAAA
+	case types.RecordTypeTXT:
+		return dnsmessage.TypeTXT
+	case types.RecordTypeCNAME:
+		return dnsmessage.TypeCNAME
+	default:
+		return 0
+	}
+}
+
+func (s *Server) reply(
+	req dnsmessage.Header, question *dnsmessage.Question, rcode dnsmessage.RCode, records []types.Record, maxSize int,
+) ([]byte, error) {
+
+	authoritative := rcode == dnsmessage.RCodeSuccess || rcode == dnsmessage.RCodeNameError
+
+	header := dnsmessage.Header{
+		ID:               req.ID,
+		Response:         true,
+		OpCode:           req.OpCode,
+		Authoritative:    authoritative,
+		RecursionDesired: req.RecursionDesired,
+		RCode:            rcode,
+	}
+
+	// Negative Answers Carry The SOA So Resolvers Know How Long To Cache Them (RFC 2308)
+	var soa *dnsmessage.Resource
+	if authoritative && len(records) == 0 {
+		var err error
+		if soa, err = s.soa(); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := buildMessage(header, question, records, soa)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxSize > 0 && len(res) > maxSize {
+		header.Truncated = true
+		return buildMessage(header, question, nil, nil)
+	}
+
+	return res, nil
+}
+
+// soa is the zone's start of authority, as served in negative answers
+func (s *Server) soa() (*dnsmessage.Resource, error) {
+	apex, err := dnsmessage.NewName(s.zone[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	ns, err := dnsmessage.NewName("ns" + s.zone)
+	if err != nil {
+		return nil, err
+	}
+
+	mbox, err := dnsmessage.NewName("hostmaster" + s.zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dnsmessage.Resource{
+		Header: dnsmessage.ResourceHeader{Name: apex, Class: dnsmessage.ClassINET, TTL: DefaultNegativeTTL},
+		Body: &dnsmessage.SOAResource{
+			NS:      ns,
+			MBox:    mbox,
+			Refresh: soaRefresh,
+			Retry:   soaRetry,
+			Expire:  soaExpire,
+			MinTTL:  DefaultNegativeTTL,
+		},
+	}, nil
+}
+
+func buildMessage(
+	header dnsmessage.Header, question *dnsmessage.Question, records []types.Record, soa *dnsmessage.Resource,
+) ([]byte, error) {
+
+	b := dnsmessage.NewBuilder(make([]byte, 0, maxUDPSize), header)
+	b.EnableCompression()
+
+	if err := b.StartQuestions(); err != nil {
+		return nil, err
+	}
+
+	if question == nil {
+		return b.Finish()
+	}
+
+	if err := b.Question(*question); err != nil {
+		return nil, err
+	}
+
+	if err := b.StartAnswers(); err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if err := addResource(&b, question.Name, record); err != nil {
+			return nil, err
+		}
+	}
+
+	if soa == nil {
+		return b.Finish()
+	}
+
+	if err := b.StartAuthorities(); err != nil {
+		return nil, err
+	}
+
+	if err := b.SOAResource(soa.Header, *soa.Body.(*dnsmessage.SOAResource)); err != nil {
+		return nil, err
+	}
+
+	return b.Finish()
+}
+
+func addResource(b *dnsmessage.Builder, name dnsmessage.Name, record types.Record) error {
+	header := dnsmessage.ResourceHeader{Name: name, Class: dnsmessage.ClassINET, TTL: record.TTL}
+
+	switch record.Type {
+	case types.RecordTypeA:
+		var a dnsmessage.AResource
+		copy(a.A[:], net.ParseIP(record.Value).To4())
+		return b.AResource(header, a)
+
+	case types.RecordTypeAAAA:
+		var aaaa dnsmessage.AAAAResource
+		copy(aaaa.AAAA[:], net.ParseIP(record.Value).To16())
+		return b.AAAAResource(header, aaaa)
+
+	case types.RecordTypeCNAME:
+		target, err := dnsmessage.NewName(strings.TrimSuffix(record.Value, ".") + ".")
+		if err != nil {
+			return err
+		}
+		return b.CNAMEResource(header, dnsmessage.CNAMEResource{CNAME: target})
+
+	case types.RecordTypeTXT:
+		return b.TXTResource(header, dnsmessage.TXTResource{TXT: splitTXT(record.Value)})
+
+	default:
+		return nil
+	}
+}
+
+// splitTXT cuts a TXT value into the character strings it is served as, none
+// longer than maxTXTString. Clients join them back together.
+func splitTXT(value string) []string {
+	strs := []string{}
+	for len(value) > maxTXTString {
+		strs = append(strs, value[:maxTXTString])
+		value = value[maxTXTString:]
+	}
+
+	return append(strs, value)
+}
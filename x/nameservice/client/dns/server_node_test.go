@@ -0,0 +1,250 @@
+package dns_test
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/resolver"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// serveDNS runs a DNS server over node, as dns-server does, returning the
+// address it answers on
+func serveDNS(t *testing.T, ctx context.Context, node *app.TestNode) string {
+	t.Helper()
+
+	// Cached Answers Outlive The Test, So Only Events Can Refresh Them
+	res := resolver.New(nsclient.NewClient(node.Client).WithQueryRoute(nameservice.StoreKey), 0, time.Hour)
+	go res.Watch(ctx, node.Client)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := conn.LocalAddr().String()
+	conn.Close()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- dns.NewServer(res, dns.DefaultZone, log.NewNopLogger()).ListenAndServe(ctx, addr) }()
+
+	// The Server Is Up Once It Answers Over TCP
+	for i := 0; ; i++ {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			return addr
+		}
+
+		select {
+		case err := <-errCh:
+			t.Fatal(err)
+		case <-time.After(10 * time.Millisecond):
+		}
+		if i == 500 {
+			t.Fatalf("the DNS server didn't start on %s", addr)
+		}
+	}
+}
+
+func question(t *testing.T, name string, qtype dnsmessage.Type) []byte {
+	t.Helper()
+
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 7, RecursionDesired: true})
+	if err := b.StartQuestions(); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Question(dnsmessage.Question{Name: dnsmessage.MustNewName(name), Type: qtype, Class: dnsmessage.ClassINET}); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := b.Finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+// exchangeUDP asks the server at addr a single question over UDP
+func exchangeUDP(t *testing.T, addr, name string, qtype dnsmessage.Type) dnsmessage.Message {
+	t.Helper()
+
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(10 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.Write(question(t, name, qtype)); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := make([]byte, 65535)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var msg dnsmessage.Message
+	if err := msg.Unpack(buf[:n]); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// exchangeTCP asks the server at addr a single length-prefixed question over TCP
+func exchangeTCP(t *testing.T, addr, name string, qtype dnsmessage.Type) dnsmessage.Message {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(10 * time.Second)); err != nil {
+		t.Fatal(err)
+	}
+
+	req := question(t, name, qtype)
+	if err := binary.Write(conn, binary.BigEndian, uint16(len(req))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := conn.Write(req); err != nil {
+		t.Fatal(err)
+	}
+
+	var size uint16
+	if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+		t.Fatal(err)
+	}
+	res := make([]byte, size)
+	if _, err := io.ReadFull(conn, res); err != nil {
+		t.Fatal(err)
+	}
+
+	var msg dnsmessage.Message
+	if err := msg.Unpack(res); err != nil {
+		t.Fatal(err)
+	}
+	return msg
+}
+
+// answerA returns the address of msg's only A answer
+func answerA(t *testing.T, msg dnsmessage.Message) string {
+	t.Helper()
+
+	if msg.RCode != dnsmessage.RCodeSuccess || len(msg.Answers) != 1 {
+		t.Fatalf("got rcode %v with %d answers, want one A record", msg.RCode, len(msg.Answers))
+	}
+	a, ok := msg.Answers[0].Body.(*dnsmessage.AResource)
+	if !ok {
+		t.Fatalf("answer is %T, want A", msg.Answers[0].Body)
+	}
+	return net.IP(a.A[:]).String()
+}
+
+func TestServerAgainstNode(t *testing.T) {
+	node, err := app.StartTestNode(nameservice.DefaultGenesisState())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10))
+	long := strings.Repeat("x", 600)
+	if err := node.Deliver(
+		nameservice.NewMsgBuyName("alice", price, node.Account),
+		nameservice.NewMsgSetRecords("alice", []nameservice.Record{
+			nameservice.NewRecord(types.RecordTypeA, "10.0.0.1", 60),
+			nameservice.NewRecord(types.RecordTypeAAAA, "fe80::1", 60),
+			nameservice.NewRecord(types.RecordTypeTXT, "hello", 60),
+		}, node.Account),
+		nameservice.NewMsgBuyName("bob", price, node.Account),
+		nameservice.NewMsgSetName("bob", "10.0.0.2", node.Account),
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Deliver(
+		nameservice.NewMsgBuyName("long", price, node.Account),
+		nameservice.NewMsgSetName("long", long, node.Account),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	addr := serveDNS(t, ctx, node)
+
+	// Typed Records, And A Value Standing In For Them
+	if ip := answerA(t, exchangeUDP(t, addr, "alice.ns.", dnsmessage.TypeA)); ip != "10.0.0.1" {
+		t.Errorf("alice.ns. A is %s, want 10.0.0.1", ip)
+	}
+	if msg := exchangeUDP(t, addr, "alice.ns.", dnsmessage.TypeAAAA); len(msg.Answers) != 1 || msg.Answers[0].Header.Type != dnsmessage.TypeAAAA {
+		t.Errorf("alice.ns. AAAA got %+v, want its AAAA record", msg.Answers)
+	}
+	if ip := answerA(t, exchangeUDP(t, addr, "bob.ns.", dnsmessage.TypeA)); ip != "10.0.0.2" {
+		t.Errorf("bob.ns. A is %s, want its value 10.0.0.2", ip)
+	}
+	if msg := exchangeTCP(t, addr, "alice.ns.", dnsmessage.TypeTXT); len(msg.Answers) != 1 {
+		t.Errorf("alice.ns. TXT got %d answers over TCP, want 1", len(msg.Answers))
+	}
+
+	// A Value Too Long For UDP Is Truncated There, And Arrives Whole Over TCP
+	if msg := exchangeUDP(t, addr, "long.ns.", dnsmessage.TypeTXT); !msg.Truncated || len(msg.Answers) != 0 {
+		t.Errorf("long.ns. TXT got %d answers over UDP, want an empty truncated response", len(msg.Answers))
+	}
+	msg := exchangeTCP(t, addr, "long.ns.", dnsmessage.TypeTXT)
+	if len(msg.Answers) != 1 {
+		t.Fatalf("long.ns. TXT got %d answers over TCP, want 1", len(msg.Answers))
+	}
+	if txt, ok := msg.Answers[0].Body.(*dnsmessage.TXTResource); !ok || len(txt.TXT) != 3 || strings.Join(txt.TXT, "") != long {
+		t.Errorf("long.ns. TXT is %+v, want its value in three character strings", msg.Answers[0].Body)
+	}
+
+	// Unregistered Names Don't Exist, And Other Zones Aren't Answered
+	if msg := exchangeUDP(t, addr, "carol.ns.", dnsmessage.TypeA); msg.RCode != dnsmessage.RCodeNameError || len(msg.Authorities) != 1 {
+		t.Errorf("carol.ns. got rcode %v with %d authorities, want NXDOMAIN with the SOA", msg.RCode, len(msg.Authorities))
+	}
+	if msg := exchangeUDP(t, addr, "alice.com.", dnsmessage.TypeA); msg.RCode != dnsmessage.RCodeRefused {
+		t.Errorf("alice.com. got rcode %v, want REFUSED", msg.RCode)
+	}
+
+	// A Committed Change Replaces The Cached Answer, And A Deletion Removes It
+	if err := node.Deliver(nameservice.NewMsgSetName("bob", "10.0.0.3", node.Account)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; answerA(t, exchangeUDP(t, addr, "bob.ns.", dnsmessage.TypeA)) != "10.0.0.3"; i++ {
+		if i == 500 {
+			t.Fatal("bob.ns. still answers with its old value")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if err := node.Deliver(nameservice.NewMsgDeleteName("bob", node.Account)); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; exchangeUDP(t, addr, "bob.ns.", dnsmessage.TypeA).RCode != dnsmessage.RCodeNameError; i++ {
+		if i == 500 {
+			t.Fatal("bob.ns. still answers once deleted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,252 @@
+package dns
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/resolver"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+func newTestServer(t *testing.T) (*Server, *nsclient.MockClient) {
+	t.Helper()
+
+	backend := nsclient.NewMockClient()
+	backend.SetWhoIs("alice", types.WhoIs{
+		Owner: sdk.AccAddress([]byte("alice_______________")),
+		Records: []types.Record{
+			types.NewRecord(types.RecordTypeA, "10.0.0.1", 60),
+			types.NewRecord(types.RecordTypeTXT, "hello", 60),
+		},
+	})
+	backend.SetWhoIs("long", types.WhoIs{Value: strings.Repeat("x", 600)})
+	backend.SetWhoIs("www.alice", types.WhoIs{
+		Records: []types.Record{types.NewRecord(types.RecordTypeCNAME, "alice.ns", 60)},
+	})
+
+	return NewServer(resolver.New(backend, 0, time.Minute), DefaultZone, log.NewNopLogger()), backend
+}
+
+func query(t *testing.T, name string, qtype dnsmessage.Type) []byte {
+	t.Helper()
+
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 42, RecursionDesired: true})
+	if err := b.StartQuestions(); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName(name),
+		Type:  qtype,
+		Class: dnsmessage.ClassINET,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := b.Finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req
+}
+
+func handle(t *testing.T, s *Server, name string, qtype dnsmessage.Type, maxSize int) dnsmessage.Message {
+	t.Helper()
+
+	res, err := s.Handle(query(t, name, qtype), maxSize)
+	if err != nil {
+		t.Fatalf("Handle(%s): %v", name, err)
+	}
+
+	var msg dnsmessage.Message
+	if err := msg.Unpack(res); err != nil {
+		t.Fatalf("unpacking response for %s: %v", name, err)
+	}
+	return msg
+}
+
+func assertSOA(t *testing.T, msg dnsmessage.Message) {
+	t.Helper()
+
+	if len(msg.Authorities) != 1 {
+		t.Fatalf("got %d authority records, want the zone's SOA", len(msg.Authorities))
+	}
+
+	soa, ok := msg.Authorities[0].Body.(*dnsmessage.SOAResource)
+	if !ok {
+		t.Fatalf("authority record is %T, want SOA", msg.Authorities[0].Body)
+	}
+	if name := msg.Authorities[0].Header.Name.String(); name != "ns." {
+		t.Errorf("SOA owner is %s, want ns.", name)
+	}
+	if soa.MinTTL != DefaultNegativeTTL {
+		t.Errorf("SOA minimum is %d, want %d", soa.MinTTL, DefaultNegativeTTL)
+	}
+}
+
+func TestHandleAnswers(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	msg := handle(t, s, "alice.ns.", dnsmessage.TypeA, maxUDPSize)
+	if msg.RCode != dnsmessage.RCodeSuccess || !msg.Authoritative {
+		t.Fatalf("got rcode %v (authoritative %v), want an authoritative answer", msg.RCode, msg.Authoritative)
+	}
+	if len(msg.Answers) != 1 || len(msg.Authorities) != 0 {
+		t.Fatalf("got %d answers and %d authorities, want a single answer", len(msg.Answers), len(msg.Authorities))
+	}
+	if a := msg.Answers[0].Body.(*dnsmessage.AResource).A; !net.IP(a[:]).Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("got A %v, want 10.0.0.1", net.IP(a[:]))
+	}
+
+	// A CNAME Answers Every Type
+	msg = handle(t, s, "www.alice.ns.", dnsmessage.TypeAAAA, maxUDPSize)
+	if len(msg.Answers) != 1 || msg.Answers[0].Header.Type != dnsmessage.TypeCNAME {
+		t.Fatalf("got %v, want the CNAME", msg.Answers)
+	}
+}
+
+func TestHandleNegativeAnswersCarrySOA(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	// NXDOMAIN
+	msg := handle(t, s, "bob.ns.", dnsmessage.TypeA, maxUDPSize)
+	if msg.RCode != dnsmessage.RCodeNameError {
+		t.Fatalf("got rcode %v, want NXDOMAIN", msg.RCode)
+	}
+	assertSOA(t, msg)
+
+	// NODATA
+	msg = handle(t, s, "alice.ns.", dnsmessage.TypeAAAA, maxUDPSize)
+	if msg.RCode != dnsmessage.RCodeSuccess || len(msg.Answers) != 0 {
+		t.Fatalf("got rcode %v with %d answers, want NODATA", msg.RCode, len(msg.Answers))
+	}
+	assertSOA(t, msg)
+
+	// Refused Questions Aren't Ours To Describe
+	msg = handle(t, s, "alice.example.", dnsmessage.TypeA, maxUDPSize)
+	if msg.RCode != dnsmessage.RCodeRefused || len(msg.Authorities) != 0 {
+		t.Fatalf("got rcode %v with %d authorities, want a bare refusal", msg.RCode, len(msg.Authorities))
+	}
+}
+
+func TestHandleSplitsLongTXT(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	// Too Large For UDP, So The Client Is Told To Retry Over TCP
+	msg := handle(t, s, "long.ns.", dnsmessage.TypeTXT, maxUDPSize)
+	if !msg.Truncated || len(msg.Answers) != 0 {
+		t.Fatalf("got truncated %v with %d answers, want an empty truncated reply", msg.Truncated, len(msg.Answers))
+	}
+
+	msg = handle(t, s, "long.ns.", dnsmessage.TypeTXT, 0)
+	if msg.RCode != dnsmessage.RCodeSuccess || len(msg.Answers) != 1 {
+		t.Fatalf("got rcode %v with %d answers, want the TXT record", msg.RCode, len(msg.Answers))
+	}
+
+	txt := msg.Answers[0].Body.(*dnsmessage.TXTResource).TXT
+	if len(txt) != 3 || len(txt[0]) != maxTXTString || len(txt[2]) != 600-2*maxTXTString {
+		t.Errorf("got TXT strings of lengths %d, want 255, 255 and 90", lengths(txt))
+	}
+	if strings.Join(txt, "") != strings.Repeat("x", 600) {
+		t.Error("TXT strings don't join back into the value")
+	}
+}
+
+func TestServeUDP(t *testing.T) {
+	s, backend := newTestServer(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	go s.serveUDP(conn) // nolint: errcheck
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	exchange := func(name string) dnsmessage.Message {
+		if err := client.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Write(query(t, name, dnsmessage.TypeTXT)); err != nil {
+			t.Fatal(err)
+		}
+
+		buf := make([]byte, maxUDPSize)
+		n, err := client.Read(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var msg dnsmessage.Message
+		if err := msg.Unpack(buf[:n]); err != nil {
+			t.Fatal(err)
+		}
+		return msg
+	}
+
+	msg := exchange("alice.ns.")
+	if msg.ID != 42 || len(msg.Answers) != 1 {
+		t.Fatalf("got id %d with %d answers, want the TXT record", msg.ID, len(msg.Answers))
+	}
+
+	// Served From The Resolver's Cache Until It Is Invalidated
+	backend.DeleteWhoIs("alice")
+	if msg := exchange("alice.ns."); msg.RCode != dnsmessage.RCodeSuccess {
+		t.Fatalf("got rcode %v, want the cached answer", msg.RCode)
+	}
+
+	s.resolver.Invalidate("alice")
+	if msg := exchange("alice.ns."); msg.RCode != dnsmessage.RCodeNameError {
+		t.Fatalf("got rcode %v, want NXDOMAIN once invalidated", msg.RCode)
+	}
+}
+
+func TestWriteZoneSplitsLongTXT(t *testing.T) {
+	short := `say "hi"`
+	entries := []ZoneEntry{
+		{Name: "alice", Records: []types.Record{types.NewRecord(types.RecordTypeTXT, short, 60)}},
+		{Name: "long", Records: []types.Record{types.RecordFromValue(strings.Repeat("a", 300))}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteZone(&buf, DefaultZone, 7, entries); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `"` + strings.Repeat("a", maxTXTString) + `" "` + strings.Repeat("a", 300-maxTXTString) + `"`
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("TXT value wasn't split into 255 byte strings:\n%s", buf.String())
+	}
+
+	// Records Longer Than A Single String Can't Be Set, So Only Short Ones Round Trip
+	parsed, err := ParseZone(strings.NewReader(strings.Split(buf.String(), "long\t")[0]), DefaultZone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed) != 1 || parsed[0].Records[0].Value != short {
+		t.Errorf("got %v, want %q back", parsed, short)
+	}
+}
+
+func lengths(strs []string) []int {
+	ns := make([]int, len(strs))
+	for i, str := range strs {
+		ns[i] = len(str)
+	}
+	return ns
+}
@@ -0,0 +1,314 @@
+package dns
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// ZoneEntry is a name together with the records it is served with
+type ZoneEntry struct {
+	Name    string
+	Records []types.Record
+}
+
+// WriteZone writes entries as an RFC 1035 master file for zone. The SOA serial
+// is the height the entries were read at, so re-exports order correctly.
+func WriteZone(w io.Writer, zone string, serial int64, entries []ZoneEntry) error {
+	origin := strings.Trim(strings.ToLower(zone), ".") + "."
+
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "$ORIGIN %s\n", origin)
+	fmt.Fprintf(bw, "$TTL %d\n", types.DefaultRecordTTL)
+	fmt.Fprintf(bw, "@\tIN\tSOA\tns.%s hostmaster.%s %d %d %d %d %d\n",
+		origin, origin, serial, soaRefresh, soaRetry, soaExpire, DefaultNegativeTTL)
+	fmt.Fprintf(bw, "@\tIN\tNS\tns.%s\n", origin)
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	for _, entry := range entries {
+		for _, record := range entry.Records {
+			if recordType(record.Type) == 0 {
+				continue
+			}
+
+			value := record.Value
+			switch record.Type {
+			case types.RecordTypeTXT:
+				value = quoteTXT(value)
+			case types.RecordTypeCNAME:
+				value = strings.TrimSuffix(value, ".") + "."
+			}
+
+			fmt.Fprintf(bw, "%s\t%d\tIN\t%s\t%s\n", entry.Name, record.TTL, record.Type, value)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// ParseZone reads the A, AAAA, TXT and CNAME records of an RFC 1035 master file
+// into entries, in the order names first appear. Other record types (SOA, NS,
+// MX, ...) are skipped, and owners outside zone are rejected.
+func ParseZone(r io.Reader, zone string) ([]ZoneEntry, error) {
+	origin := strings.Trim(strings.ToLower(zone), ".") + "."
+	ttl := types.DefaultRecordTTL
+
+	var entries []ZoneEntry
+	index := make(map[string]int)
+	owner := ""
+
+	lines, err := logicalLines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		fields, err := tokenize(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		// Directives
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %d: malformed $ORIGIN", line.number)
+			}
+			origin = absoluteName(fields[1], origin)
+			continue
+
+		case "$TTL":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %d: malformed $TTL", line.number)
+			}
+			value, err := strconv.ParseUint(fields[1], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: malformed $TTL: %w", line.number, err)
+			}
+			ttl = uint32(value)
+			continue
+
+		case "$INCLUDE":
+			return nil, fmt.Errorf("line %d: $INCLUDE is not supported", line.number)
+		}
+
+		// A Record Starting With Blank Space Reuses The Previous Owner
+		if !line.continued {
+			owner = absoluteName(fields[0], origin)
+			fields = fields[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record without an owner", line.number)
+		}
+
+		// Optional TTL & Class, In Either Order
+		recordTTL := ttl
+		for len(fields) > 0 {
+			if value, err := strconv.ParseUint(fields[0], 10, 32); err == nil {
+				recordTTL = uint32(value)
+				fields = fields[1:]
+				continue
+			}
+			if strings.EqualFold(fields[0], "IN") {
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: record without type or data", line.number)
+		}
+
+		recordType := strings.ToUpper(fields[0])
+		data := fields[1:]
+
+		switch recordType {
+		case types.RecordTypeA, types.RecordTypeAAAA, types.RecordTypeCNAME:
+			if len(data) != 1 {
+				return nil, fmt.Errorf("line %d: malformed %s record", line.number, recordType)
+			}
+		case types.RecordTypeTXT:
+			data = []string{strings.Join(data, "")}
+		default:
+			continue
+		}
+
+		name, err := relativeName(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		value := data[0]
+		if recordType == types.RecordTypeCNAME {
+			value = strings.TrimSuffix(absoluteName(value, origin), ".")
+		}
+
+		record := types.NewRecord(recordType, value, recordTTL)
+		if err := record.Validate(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		i, ok := index[name]
+		if !ok {
+			i = len(entries)
+			index[name] = i
+			entries = append(entries, ZoneEntry{Name: name})
+		}
+		entries[i].Records = append(entries[i].Records, record)
+	}
+
+	return entries, nil
+}
+
+// logicalLine is a record or directive with comments stripped and
+// parenthesised continuations joined
+type logicalLine struct {
+	number    int
+	text      string
+	continued bool
+}
+
+func logicalLines(r io.Reader) ([]logicalLine, error) {
+	var lines []logicalLine
+	var current *logicalLine
+	depth := 0
+
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		raw := scanner.Text()
+		text := stripComment(raw)
+
+		if current == nil {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			current = &logicalLine{
+				number:    number,
+				continued: raw[0] == ' ' || raw[0] == '\t',
+			}
+		}
+
+		depth += strings.Count(text, "(") - strings.Count(text, ")")
+		text = strings.NewReplacer("(", " ", ")", " ").Replace(text)
+		current.text += " " + text
+
+		if depth <= 0 {
+			lines = append(lines, *current)
+			current = nil
+			depth = 0
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+
+	return lines, nil
+}
+
+// stripComment drops everything after a ; that isn't inside a quoted string
+func stripComment(line string) string {
+	quoted := false
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '"':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return line[:i]
+			}
+		}
+	}
+	return line
+}
+
+// tokenize splits a line on blank space, keeping quoted strings (with their
+// backslash escapes resolved) as single fields
+func tokenize(line string) ([]string, error) {
+	var fields []string
+	var field strings.Builder
+	inField, quoted := false, false
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case c == '\\' && i+1 < len(line):
+			i++
+			field.WriteByte(line[i])
+			inField = true
+		case c == '"':
+			quoted = !quoted
+			inField = true
+		case (c == ' ' || c == '\t') && !quoted:
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteByte(c)
+			inField = true
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("unterminated quoted string")
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+
+	return fields, nil
+}
+
+// quoteTXT renders a TXT value as quoted character strings, split the same
+// way the server sends them
+func quoteTXT(value string) string {
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	strs := splitTXT(value)
+	for i, str := range strs {
+		strs[i] = `"` + escape.Replace(str) + `"`
+	}
+
+	return strings.Join(strs, " ")
+}
+
+// absoluteName qualifies a (possibly relative) domain name against origin
+func absoluteName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// relativeName maps an absolute owner under zone onto a nameservice name
+func relativeName(owner, zone string) (string, error) {
+	suffix := "." + strings.Trim(strings.ToLower(zone), ".") + "."
+	if !strings.HasSuffix(owner, suffix) || len(owner) == len(suffix) {
+		return "", fmt.Errorf("%s is not a name under %s", owner, suffix[1:])
+	}
+	return strings.TrimSuffix(owner, suffix), nil
+}
@@ -0,0 +1,14 @@
+package client
+
+import (
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Errors the module returns. Query errors carry the module's codespace and
+// code, which the client maps back onto these, so callers can test for them
+// with errors.Is or e.g. ErrNameDoesNotExist.Is(err).
+var (
+	ErrNameDoesNotExist = types.ErrNameDoesNotExist
+	ErrInvalidRecord    = types.ErrInvalidRecord
+	ErrInvalidName      = types.ErrInvalidName
+)
@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"golang.org/x/net/dns/dnsmessage"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+)
+
+const (
+	dnsMessageType = "application/dns-message"
+
+	// Largest DNS Message Accepted In A POST Body
+	maxDNSMessageSize = 65535
+)
+
+// dnsQueryHandler serves RFC 8484 requests, either base64url encoded in the
+// dns parameter of a GET or as the body of a POST
+func dnsQueryHandler(server *dns.Server) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req []byte
+		var err error
+
+		switch r.Method {
+		case http.MethodGet:
+			req, err = base64.RawURLEncoding.DecodeString(r.URL.Query().Get("dns"))
+		default:
+			if r.Header.Get("Content-Type") != dnsMessageType {
+				rest.WriteErrorResponse(w, http.StatusUnsupportedMediaType, fmt.Sprintf("expected %s", dnsMessageType))
+				return
+			}
+			req, err = ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxDNSMessageSize))
+		}
+
+		if err != nil || len(req) == 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "missing or malformed DNS message")
+			return
+		}
+
+		res, err := server.Handle(req, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		w.Header().Set("Content-Type", dnsMessageType)
+		if ttl, ok := minTTL(res); ok {
+			w.Header().Set("Cache-Control", fmt.Sprintf("max-age=%d", ttl))
+		}
+		_, _ = w.Write(res)
+	}
+}
+
+// minTTL returns the smallest TTL among the answers of a DNS response, which
+// bounds how long HTTP caches may keep it (RFC 8484 5.1)
+func minTTL(msg []byte) (uint32, bool) {
+	var p dnsmessage.Parser
+	if _, err := p.Start(msg); err != nil {
+		return 0, false
+	}
+
+	if err := p.SkipAllQuestions(); err != nil {
+		return 0, false
+	}
+
+	var ttl uint32
+	found := false
+	for {
+		header, err := p.AnswerHeader()
+		if err != nil {
+			break
+		}
+
+		if !found || header.TTL < ttl {
+			ttl = header.TTL
+			found = true
+		}
+
+		if err := p.SkipAnswer(); err != nil {
+			break
+		}
+	}
+
+	return ttl, found
+}
@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+
+	"github.com/gorilla/mux"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/resolver"
+)
+
+// API Version Reported By The JSON Resolution Routes
+const APIVersion = "v1"
+
+// RegisterRoutes mounts the resolution gateway: DNS-over-HTTPS (RFC 8484) at
+// /dns-query, the versioned JSON resolution API under /v1/resolve and the
+// DoH cache's counters at /v1/resolver/stats
+func RegisterRoutes(cliCtx clicontext.CLIContext, r *mux.Router) {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "nameservice-gateway")
+
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		logger.Error("Resolution gateway disabled", "err", err)
+		return
+	}
+
+	// DoH Answers Come From A Caching Resolver Kept Current By Node Events
+	res := resolver.New(nsclient.NewClient(node), resolver.DefaultSize, resolver.DefaultTTL).WithLogger(logger)
+	go res.Watch(context.Background(), node)
+
+	server := dns.NewServer(res, dns.DefaultZone, logger)
+
+	r.HandleFunc("/dns-query", dnsQueryHandler(server)).Methods("GET", "POST")
+	r.HandleFunc("/"+APIVersion+"/resolve/{name}", resolveHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/"+APIVersion+"/resolver/stats", statsHandler(res)).Methods("GET")
+}
+
+// statsResponse is the resolver's counters along with the hit rate they give
+type statsResponse struct {
+	resolver.Stats
+	HitRate float64 `json:"hit_rate"`
+}
+
+func statsHandler(res *resolver.Resolver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		stats := res.Stats()
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(statsResponse{Stats: stats, HitRate: stats.HitRate()}); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+	}
+}
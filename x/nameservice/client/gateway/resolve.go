@@ -0,0 +1,160 @@
+package gateway
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/dns"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// ResolveResponse is the v1 resolution document. Its fields are part of the
+// versioned API and are only ever added to within v1.
+type ResolveResponse struct {
+	Version string        `json:"version"`
+	Name    string        `json:"name"`
+	Owner   string        `json:"owner"`
+	Value   string        `json:"value"`
+	Price   string        `json:"price"`
+	Records []RecordJSON  `json:"records"`
+	Proof   ProofMetadata `json:"proof"`
+}
+
+// RecordJSON is a typed record as served by the v1 API
+type RecordJSON struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	TTL   uint32 `json:"ttl"`
+}
+
+// ProofMetadata lets a client check the answer against the app hash of the
+// block following Height
+type ProofMetadata struct {
+	Height int64         `json:"height"`
+	Store  string        `json:"store"`
+	Key    string        `json:"key"`
+	Ops    []ProofOpJSON `json:"ops"`
+}
+
+// ProofOpJSON is a single merkle proof operation, with the key hex encoded and
+// the data base64 encoded
+type ProofOpJSON struct {
+	Type string `json:"type"`
+	Key  string `json:"key"`
+	Data string `json:"data"`
+}
+
+// resolveHandler serves /v1/resolve/{name}[?type=A&height=N]. The name may be
+// given with or without the zone suffix.
+func resolveHandler(cliCtx clicontext.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		name := strings.TrimSuffix(strings.TrimSuffix(mux.Vars(r)["name"], "."), "."+dns.DefaultZone)
+		if err := types.ValidateName(name); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		recordType := strings.ToUpper(r.URL.Query().Get("type"))
+		if recordType != "" && !supportedRecordType(recordType) {
+			err := sdkerrors.Wrapf(types.ErrInvalidRecord, "unsupported record type %s", recordType)
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		node, err := cliCtx.GetNode()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		key := types.WhoIsKey(name)
+		opts := rpcclient.ABCIQueryOptions{Height: cliCtx.Height, Prove: true}
+
+		result, err := node.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", types.StoreKey), key, opts)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// The Node Answered, But Refused The Query (e.g. A Height It Doesn't Have)
+		resp := result.Response
+		if !resp.IsOK() {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, resp.Log)
+			return
+		}
+
+		if len(resp.Value) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, sdkerrors.Wrap(types.ErrNameDoesNotExist, name).Error())
+			return
+		}
+
+		var whois types.WhoIs
+		if err := cliCtx.Codec.UnmarshalBinaryBare(resp.Value, &whois); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		out := ResolveResponse{
+			Version: APIVersion,
+			Name:    name,
+			Owner:   whois.Owner.String(),
+			Value:   whois.Value,
+			Price:   whois.Price.String(),
+			Records: []RecordJSON{},
+			Proof: ProofMetadata{
+				Height: resp.Height,
+				Store:  types.StoreKey,
+				Key:    hex.EncodeToString(key),
+				Ops:    []ProofOpJSON{},
+			},
+		}
+
+		for _, record := range whois.Records {
+			if recordType == "" || record.Type == recordType {
+				out.Records = append(out.Records, RecordJSON{Type: record.Type, Value: record.Value, TTL: record.TTL})
+			}
+		}
+
+		if resp.Proof != nil {
+			for _, op := range resp.Proof.Ops {
+				out.Proof.Ops = append(out.Proof.Ops, ProofOpJSON{
+					Type: op.Type,
+					Key:  hex.EncodeToString(op.Key),
+					Data: base64.StdEncoding.EncodeToString(op.Data),
+				})
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(out); err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+	}
+}
+
+// supportedRecordType reports whether recordType is one the module stores
+func supportedRecordType(recordType string) bool {
+	switch recordType {
+	case types.RecordTypeA, types.RecordTypeAAAA, types.RecordTypeTXT, types.RecordTypeCNAME, types.RecordTypeADDR:
+		return true
+	default:
+		return false
+	}
+}
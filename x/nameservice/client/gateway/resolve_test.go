@@ -0,0 +1,111 @@
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// fakeNode answers store queries from a map of keys, or with err
+type fakeNode struct {
+	rpcclient.Client
+	store map[string][]byte
+	err   error
+}
+
+func (n fakeNode) ABCIQueryWithOptions(
+	path string, key tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+
+	if n.err != nil {
+		return nil, n.err
+	}
+
+	if opts.Height > 100 {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Code: 1, Log: "height too high"}}, nil
+	}
+
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: n.store[string(key)], Height: 100}}, nil
+}
+
+func serveResolve(t *testing.T, node fakeNode, url string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	cdc := codec.New()
+	cliCtx := clicontext.CLIContext{Codec: cdc}.WithClient(node)
+
+	r := mux.NewRouter()
+	r.HandleFunc("/"+APIVersion+"/resolve/{name}", resolveHandler(cliCtx)).Methods("GET")
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+	return w
+}
+
+func TestResolveHandler(t *testing.T) {
+	whois := types.WhoIs{
+		Owner: sdk.AccAddress([]byte("alice_______________")),
+		Price: sdk.NewCoins(sdk.NewInt64Coin("nametoken", 5)),
+		Records: []types.Record{
+			types.NewRecord(types.RecordTypeA, "10.0.0.1", 60),
+			types.NewRecord(types.RecordTypeTXT, "hello", 60),
+		},
+	}
+	node := fakeNode{store: map[string][]byte{
+		string(types.WhoIsKey("alice")): codec.New().MustMarshalBinaryBare(whois),
+	}}
+
+	tests := []struct {
+		url     string
+		node    fakeNode
+		status  int
+		records int
+	}{
+		{"/v1/resolve/alice", node, http.StatusOK, 2},
+		{"/v1/resolve/alice.ns.?type=txt", node, http.StatusOK, 1},
+		{"/v1/resolve/bob", node, http.StatusNotFound, 0},
+		{"/v1/resolve/Not_A_Name", node, http.StatusBadRequest, 0},
+		{"/v1/resolve/alice?type=MX", node, http.StatusBadRequest, 0},
+		{"/v1/resolve/alice?height=500", node, http.StatusBadRequest, 0},
+		{"/v1/resolve/alice", fakeNode{err: errors.New("connection refused")}, http.StatusInternalServerError, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			w := serveResolve(t, tc.node, tc.url)
+			if w.Code != tc.status {
+				t.Fatalf("got status %d, want %d: %s", w.Code, tc.status, w.Body)
+			}
+			if tc.status != http.StatusOK {
+				return
+			}
+
+			var res ResolveResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			if res.Name != "alice" || len(res.Records) != tc.records {
+				t.Errorf("got %s with %d records, want alice with %d", res.Name, len(res.Records), tc.records)
+			}
+			if res.Proof.Key != fmt.Sprintf("%x", types.WhoIsKey("alice")) {
+				t.Errorf("got proof key %s, want the name's store key", res.Proof.Key)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+
+	gographql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// Limits On What A Single Query May Ask For
+const (
+	maxDepth       = 10
+	maxParallelism = 20
+)
+
+// NewHandler returns an http.Handler answering GraphQL POSTs from backend,
+// with sales looked up through node's tx index
+func NewHandler(backend Backend, node rpcclient.Client) http.Handler {
+	schema := gographql.MustParseSchema(Schema, &resolver{backend: backend, node: node},
+		gographql.MaxDepth(maxDepth),
+		gographql.MaxParallelism(maxParallelism),
+	)
+
+	handler := &relay.Handler{Schema: schema}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Each Request Gets Its Own Loaders, So Nothing Is Cached Across Requests
+		ctx := context.WithValue(r.Context(), loadersKey{}, newLoaders(backend))
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
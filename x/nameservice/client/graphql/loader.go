@@ -0,0 +1,127 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// How Long A Batch Waits For Sibling Fields To Ask For More Keys
+const batchWindow = 2 * time.Millisecond
+
+type loadersKey struct{}
+
+// loaders memoise the lookups of a single request, so a name or owner
+// reached along several paths of a query is fetched once
+type loaders struct {
+	whois *whoisLoader
+	owned *ownedLoader
+}
+
+func newLoaders(backend Backend) *loaders {
+	return &loaders{
+		whois: &whoisLoader{backend: backend, calls: make(map[string]*whoisCall)},
+		owned: &ownedLoader{backend: backend, results: make(map[string]*ownedResult)},
+	}
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// whoisLoader deduplicates WhoIs lookups. The module has no batched whois
+// query, so distinct names are still fetched one by one.
+type whoisLoader struct {
+	backend Backend
+
+	mtx   sync.Mutex
+	calls map[string]*whoisCall
+}
+
+type whoisCall struct {
+	once  sync.Once
+	whois types.WhoIs
+	err   error
+}
+
+func (l *whoisLoader) load(ctx context.Context, name string) (types.WhoIs, error) {
+	l.mtx.Lock()
+	call, ok := l.calls[name]
+	if !ok {
+		call = &whoisCall{}
+		l.calls[name] = call
+	}
+	l.mtx.Unlock()
+
+	call.once.Do(func() {
+		call.whois, call.err = l.backend.WhoIs(ctx, name)
+	})
+
+	return call.whois, call.err
+}
+
+// ownedLoader batches the owner→names lookups made within batchWindow of one
+// another into a single owned_by query
+type ownedLoader struct {
+	backend Backend
+
+	mtx     sync.Mutex
+	results map[string]*ownedResult
+	pending []sdk.AccAddress
+}
+
+type ownedResult struct {
+	done  chan struct{}
+	names []string
+	err   error
+}
+
+func (l *ownedLoader) load(ctx context.Context, owner sdk.AccAddress) ([]string, error) {
+	key := owner.String()
+
+	l.mtx.Lock()
+	result, ok := l.results[key]
+	if !ok {
+		result = &ownedResult{done: make(chan struct{})}
+		l.results[key] = result
+
+		// The First Key Of A Batch Schedules Its Dispatch
+		if len(l.pending) == 0 {
+			time.AfterFunc(batchWindow, func() { l.dispatch(ctx) })
+		}
+		l.pending = append(l.pending, owner)
+	}
+	l.mtx.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-result.done:
+		return result.names, result.err
+	}
+}
+
+func (l *ownedLoader) dispatch(ctx context.Context) {
+	l.mtx.Lock()
+	owners := l.pending
+	l.pending = nil
+	l.mtx.Unlock()
+
+	ownedBy, err := l.backend.OwnedBy(ctx, owners)
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	for _, owner := range owners {
+		result := l.results[owner.String()]
+		result.names, result.err = ownedBy[owner.String()], err
+		if result.names == nil && err == nil {
+			result.names = []string{}
+		}
+		close(result.done)
+	}
+}
@@ -0,0 +1,342 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/watch"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Largest Page A Connection Or Sales List Returns
+const maxPage = 1000
+
+// Backend is the nameservice client the API reads from, with the batched
+// owner lookup its loaders need
+type Backend interface {
+	nsclient.Client
+	OwnedBy(ctx context.Context, owners []sdk.AccAddress) (map[string][]string, error)
+}
+
+var _ Backend = (*nsclient.NodeClient)(nil)
+
+// resolver is the root of the schema
+type resolver struct {
+	backend Backend
+	node    rpcclient.Client
+}
+
+// First Always Has A Value, Its Schema Default If Not Given
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+func (r *resolver) Name(ctx context.Context, args struct{ Name string }) (*nameResolver, error) {
+	if _, err := loadersFrom(ctx).whois.load(ctx, args.Name); err != nil {
+		if errors.Is(err, types.ErrNameDoesNotExist) || errors.Is(err, types.ErrInvalidName) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &nameResolver{root: r, name: args.Name}, nil
+}
+
+func (r *resolver) Names(ctx context.Context, args pageArgs) (*connectionResolver, error) {
+	names, err := r.backend.Names(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.connection(names, args)
+}
+
+func (r *resolver) Account(args struct{ Address string }) (*accountResolver, error) {
+	addr, err := sdk.AccAddressFromBech32(args.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResolver{root: r, address: addr}, nil
+}
+
+// connection pages through names, sorted, after the cursor
+func (r *resolver) connection(names []string, args pageArgs) (*connectionResolver, error) {
+	first, err := pageSize(args.First, maxPage)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	start := 0
+	if args.After != nil {
+		start = sort.SearchStrings(names, *args.After)
+		if start < len(names) && names[start] == *args.After {
+			start++
+		}
+	}
+
+	end := start + first
+	if end > len(names) {
+		end = len(names)
+	}
+
+	return &connectionResolver{root: r, all: names, page: names[start:end], more: end < len(names)}, nil
+}
+
+func pageSize(first int32, max int) (int, error) {
+	if first < 0 || int(first) > max {
+		return 0, fmt.Errorf("first must be between 0 and %d", max)
+	}
+	return int(first), nil
+}
+
+type connectionResolver struct {
+	root *resolver
+	all  []string
+	page []string
+	more bool
+}
+
+func (c *connectionResolver) Names() []*nameResolver {
+	names := make([]*nameResolver, len(c.page))
+	for i, name := range c.page {
+		names[i] = &nameResolver{root: c.root, name: name}
+	}
+	return names
+}
+
+func (c *connectionResolver) TotalCount() int32 {
+	return int32(len(c.all))
+}
+
+func (c *connectionResolver) PageInfo() *pageInfoResolver {
+	info := &pageInfoResolver{hasNextPage: c.more}
+	if len(c.page) > 0 {
+		info.endCursor = &c.page[len(c.page)-1]
+	}
+	return info
+}
+
+type pageInfoResolver struct {
+	endCursor   *string
+	hasNextPage bool
+}
+
+func (p *pageInfoResolver) EndCursor() *string {
+	return p.endCursor
+}
+
+func (p *pageInfoResolver) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+type nameResolver struct {
+	root *resolver
+	name string
+}
+
+func (n *nameResolver) whois(ctx context.Context) (types.WhoIs, error) {
+	return loadersFrom(ctx).whois.load(ctx, n.name)
+}
+
+func (n *nameResolver) Name() string {
+	return n.name
+}
+
+func (n *nameResolver) Value(ctx context.Context) (string, error) {
+	whois, err := n.whois(ctx)
+	return whois.Value, err
+}
+
+func (n *nameResolver) Owner(ctx context.Context) (*accountResolver, error) {
+	whois, err := n.whois(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResolver{root: n.root, address: whois.Owner}, nil
+}
+
+func (n *nameResolver) Owners(ctx context.Context) ([]*accountResolver, error) {
+	whois, err := n.whois(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	owners := whois.AllOwners()
+	accounts := make([]*accountResolver, len(owners))
+	for i, owner := range owners {
+		accounts[i] = &accountResolver{root: n.root, address: owner}
+	}
+	return accounts, nil
+}
+
+func (n *nameResolver) Threshold(ctx context.Context) (int32, error) {
+	whois, err := n.whois(ctx)
+	if whois.IsMultiOwner() {
+		return int32(whois.Threshold), err
+	}
+	return 1, err
+}
+
+func (n *nameResolver) Price(ctx context.Context) ([]*coinResolver, error) {
+	whois, err := n.whois(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := make([]*coinResolver, len(whois.Price))
+	for i, coin := range whois.Price {
+		coins[i] = &coinResolver{coin}
+	}
+	return coins, nil
+}
+
+func (n *nameResolver) Records(ctx context.Context) ([]*recordResolver, error) {
+	whois, err := n.whois(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*recordResolver, len(whois.Records))
+	for i, record := range whois.Records {
+		records[i] = &recordResolver{record}
+	}
+	return records, nil
+}
+
+// Sales are found through the node's tx index, which appd fills for every
+// event attribute
+func (n *nameResolver) Sales(args struct{ First int32 }) ([]*saleResolver, error) {
+	first, err := pageSize(args.First, maxPage)
+	if err != nil {
+		return nil, err
+	}
+
+	// Names Are Validated, So Can't Break Out Of The Quoted Query Value
+	if err := types.ValidateName(n.name); err != nil {
+		return nil, err
+	}
+
+	var sales []*saleResolver
+	for _, eventType := range []string{types.EventTypeRegisterName, types.EventTypeBuyName} {
+		query := fmt.Sprintf("%s.%s='%s'", eventType, types.AttributeKeyName, n.name)
+
+		result, err := n.root.node.TxSearch(query, false, 1, maxPage)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range result.Txs {
+			for _, event := range watch.DecodeTxResult(tx) {
+				if event.Name == n.name && event.Type == eventType {
+					sales = append(sales, &saleResolver{root: n.root, event: event})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(sales, func(i, j int) bool { return sales[i].event.Height > sales[j].event.Height })
+	if len(sales) > first {
+		sales = sales[:first]
+	}
+
+	return sales, nil
+}
+
+type accountResolver struct {
+	root    *resolver
+	address sdk.AccAddress
+}
+
+func (a *accountResolver) Address() string {
+	return a.address.String()
+}
+
+func (a *accountResolver) Names(ctx context.Context, args pageArgs) (*connectionResolver, error) {
+	names, err := loadersFrom(ctx).owned.load(ctx, a.address)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Loader's Slice Is Shared, So Page Through A Copy
+	return a.root.connection(append([]string(nil), names...), args)
+}
+
+type recordResolver struct {
+	record types.Record
+}
+
+func (r *recordResolver) Type() string {
+	return r.record.Type
+}
+
+func (r *recordResolver) Value() string {
+	return r.record.Value
+}
+
+func (r *recordResolver) TTL() int32 {
+	return int32(r.record.TTL)
+}
+
+type coinResolver struct {
+	coin sdk.Coin
+}
+
+func (c *coinResolver) Denom() string {
+	return c.coin.Denom
+}
+
+func (c *coinResolver) Amount() string {
+	return c.coin.Amount.String()
+}
+
+type saleResolver struct {
+	root  *resolver
+	event watch.Event
+}
+
+func (s *saleResolver) Buyer() (*accountResolver, error) {
+	addr, err := sdk.AccAddressFromBech32(s.event.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResolver{root: s.root, address: addr}, nil
+}
+
+func (s *saleResolver) Seller() (*accountResolver, error) {
+	if s.event.PreviousOwner == "" {
+		return nil, nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(s.event.PreviousOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountResolver{root: s.root, address: addr}, nil
+}
+
+func (s *saleResolver) Price() string {
+	return s.event.Price
+}
+
+func (s *saleResolver) Height() string {
+	return strconv.FormatInt(s.event.Height, 10)
+}
+
+func (s *saleResolver) TxHash() string {
+	return s.event.TxHash
+}
@@ -0,0 +1,71 @@
+// Package graphql serves nameservice state as a GraphQL API, so a client can
+// fetch a name, its owner's other names, its records and its sales in one
+// round-trip. Lookups made while resolving one request are deduplicated, and
+// owner→names lookups are batched into a single query.
+package graphql
+
+// Schema is the GraphQL schema served at /nameservice/graphql
+const Schema = `
+schema {
+	query: Query
+}
+
+type Query {
+	# A registered name, or null
+	name(name: String!): Name
+	# Registered names in store order, "after" being the last name of the previous page
+	names(first: Int = 100, after: String): NameConnection!
+	# An account, whether or not it owns any names
+	account(address: String!): Account
+}
+
+type Name {
+	name: String!
+	value: String!
+	owner: Account!
+	# Everyone holding the name, the owner first
+	owners: [Account!]!
+	# How many of the owners must approve a change to the name
+	threshold: Int!
+	price: [Coin!]!
+	records: [Record!]!
+	# Registrations and purchases of the name, newest first. Needs the node to index tx events.
+	sales(first: Int = 20): [Sale!]!
+}
+
+type Account {
+	address: String!
+	names(first: Int = 100, after: String): NameConnection!
+}
+
+type Record {
+	type: String!
+	value: String!
+	ttl: Int!
+}
+
+type Coin {
+	denom: String!
+	amount: String!
+}
+
+type Sale {
+	buyer: Account!
+	# Null when the name was registered rather than bought from someone
+	seller: Account
+	price: String!
+	height: String!
+	txHash: String!
+}
+
+type NameConnection {
+	names: [Name!]!
+	totalCount: Int!
+	pageInfo: PageInfo!
+}
+
+type PageInfo {
+	endCursor: String
+	hasNextPage: Boolean!
+}
+`
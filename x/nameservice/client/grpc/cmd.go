@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	gogrpc "google.golang.org/grpc"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/watch"
+)
+
+const flagListenAddr = "laddr"
+
+// ServeCommand returns the grpc-server command, which serves the Query and Tx
+// services of nameservice.proto from a node
+func ServeCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grpc-server",
+		Short: "Serve the nameservice gRPC API",
+		Long: `Serve the nameservice.v1.Query and nameservice.v1.Tx gRPC services defined by
+x/nameservice/client/grpc/nameservice.proto. Queries and broadcasts go to --node;
+Watch streams the name changes of txs committed on it.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := clicontext.NewCLIContext().WithCodec(cdc)
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "grpc-server")
+
+			client, err := nsclient.NewClientFromCLIContext(cliCtx)
+			if err != nil {
+				return err
+			}
+
+			// The Hub Gets Its Own Connection, As Subscriptions Are Per Connection & Query
+			node, err := rpcclient.NewHTTP(cliCtx.NodeURI, "/websocket")
+			if err != nil {
+				return err
+			}
+			hub := watch.NewHub(node, logger)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go hub.Run(ctx)
+
+			server := gogrpc.NewServer()
+			srv := NewServer(client, hub, cliCtx)
+			RegisterQueryServer(server, srv)
+			RegisterTxServer(server, srv)
+
+			listener, err := net.Listen("tcp", viper.GetString(flagListenAddr))
+			if err != nil {
+				return err
+			}
+
+			go func() {
+				sigs := make(chan os.Signal, 1)
+				signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+				<-sigs
+				cancel()
+				server.GracefulStop()
+			}()
+
+			logger.Info("Serving nameservice gRPC", "laddr", listener.Addr())
+			return server.Serve(listener)
+		},
+	}
+
+	cmd.Flags().String(flagListenAddr, "localhost:9090", "The address to listen on")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+
+	return cmd
+}
@@ -0,0 +1,231 @@
+// Package grpc serves the nameservice module over gRPC: queries, a stream of
+// name changes and broadcasting of signed txs. The interface is defined by
+// nameservice.proto, from which clients in other languages can be generated.
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/watch"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+var (
+	_ QueryServer = (*Server)(nil)
+	_ TxServer    = (*Server)(nil)
+)
+
+// Server implements the Query and Tx services on top of a nameservice client,
+// a watch hub for the event stream and a CLI context for broadcasting
+type Server struct {
+	client nsclient.Client
+	hub    *watch.Hub
+	cliCtx clicontext.CLIContext
+}
+
+// NewServer creates a Server. The hub must be running for Watch streams to
+// receive events.
+func NewServer(client nsclient.Client, hub *watch.Hub, cliCtx clicontext.CLIContext) *Server {
+	return &Server{
+		client: client,
+		hub:    hub,
+		cliCtx: cliCtx,
+	}
+}
+
+// Resolve implements QueryServer
+func (s *Server) Resolve(ctx context.Context, req *ResolveRequest) (*ResolveResponse, error) {
+	if err := types.ValidateName(req.Name); err != nil {
+		return nil, statusError(err)
+	}
+
+	value, err := s.client.Resolve(ctx, req.Name)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &ResolveResponse{Value: value}, nil
+}
+
+// WhoIs implements QueryServer
+func (s *Server) WhoIs(ctx context.Context, req *WhoIsRequest) (*WhoIsResponse, error) {
+	if err := types.ValidateName(req.Name); err != nil {
+		return nil, statusError(err)
+	}
+
+	whois, err := s.client.WhoIs(ctx, req.Name)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	res := &WhoIsResponse{
+		Name:  req.Name,
+		Value: whois.Value,
+		Owner: whois.Owner.String(),
+		Price: coins(whois.Price),
+	}
+	for _, record := range whois.Records {
+		res.Records = append(res.Records, &Record{Type: record.Type, Value: record.Value, TTL: record.TTL})
+	}
+
+	return res, nil
+}
+
+// Names implements QueryServer
+func (s *Server) Names(ctx context.Context, req *NamesRequest) (*NamesResponse, error) {
+	names, err := s.client.Names(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &NamesResponse{Names: names}, nil
+}
+
+// Owned implements QueryServer
+func (s *Server) Owned(ctx context.Context, req *OwnedRequest) (*OwnedResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	names, err := s.client.Owned(ctx, owner)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &OwnedResponse{Names: names}, nil
+}
+
+// Params implements QueryServer. The module has no governance parameters, so
+// these are the rules compiled into it.
+func (s *Server) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return &ParamsResponse{
+		MinNamePrice:     coins(types.NewWhoIs().Price),
+		MaxNameLength:    types.MaxNameLength,
+		DefaultRecordTTL: types.DefaultRecordTTL,
+		RecordTypes: []string{
+			types.RecordTypeA, types.RecordTypeAAAA, types.RecordTypeTXT,
+			types.RecordTypeCNAME, types.RecordTypeADDR,
+		},
+	}, nil
+}
+
+// Watch implements QueryServer
+func (s *Server) Watch(req *WatchRequest, stream Query_WatchServer) error {
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	events, cancel := s.hub.Watch(watch.Filter{Name: req.Name, Owner: req.Owner})
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "stream fell too far behind")
+			}
+
+			if err := stream.Send(nameEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Broadcast Modes By Their Proto Values
+var broadcastModes = map[BroadcastMode]string{
+	BroadcastModeBlock: flags.BroadcastBlock,
+	BroadcastModeSync:  flags.BroadcastSync,
+	BroadcastModeAsync: flags.BroadcastAsync,
+}
+
+// BroadcastTx implements TxServer
+func (s *Server) BroadcastTx(ctx context.Context, req *BroadcastTxRequest) (*BroadcastTxResponse, error) {
+	mode, ok := broadcastModes[req.Mode]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown broadcast mode %d", req.Mode)
+	}
+
+	tx, err := auth.DefaultTxDecoder(s.cliCtx.Codec)(req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// This Service Broadcasts Nameservice Txs Only
+	for _, msg := range tx.GetMsgs() {
+		if msg.Route() != types.RouterKey {
+			return nil, status.Errorf(codes.InvalidArgument, "%s is not a nameservice message", msg.Type())
+		}
+	}
+
+	res, err := s.cliCtx.WithBroadcastMode(mode).BroadcastTx(req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	out := &BroadcastTxResponse{
+		TxHash: res.TxHash,
+		Height: res.Height,
+		Code:   res.Code,
+		RawLog: res.RawLog,
+	}
+	for _, event := range watch.DecodeTxResponse(res) {
+		out.Events = append(out.Events, nameEvent(event))
+	}
+
+	return out, nil
+}
+
+func coins(c sdk.Coins) []*Coin {
+	out := make([]*Coin, len(c))
+	for i, coin := range c {
+		out[i] = &Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return out
+}
+
+func nameEvent(e watch.Event) *NameEvent {
+	return &NameEvent{
+		Type:          e.Type,
+		Name:          e.Name,
+		Owner:         e.Owner,
+		PreviousOwner: e.PreviousOwner,
+		Value:         e.Value,
+		Price:         e.Price,
+		Records:       e.Records,
+		Height:        e.Height,
+		TxHash:        e.TxHash,
+	}
+}
+
+// statusError maps the module's errors to gRPC status codes
+func statusError(err error) error {
+	switch {
+	case errors.Is(err, types.ErrNameDoesNotExist):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, types.ErrInvalidName), errors.Is(err, types.ErrInvalidRecord):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	default:
+		return status.Error(codes.Unavailable, fmt.Sprintf("query failed: %s", err))
+	}
+}
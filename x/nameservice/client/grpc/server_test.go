@@ -0,0 +1,175 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	gogrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	nsgrpc "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/grpc"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/watch"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// serve starts the gRPC services over node, returning a connection to them
+func serve(t *testing.T, ctx context.Context, node *app.TestNode) *gogrpc.ClientConn {
+	t.Helper()
+
+	hub := watch.NewHub(node.Client, log.NewNopLogger())
+	go hub.Run(ctx)
+
+	server := gogrpc.NewServer()
+	srv := nsgrpc.NewServer(nsclient.NewClient(node.Client).WithQueryRoute(nameservice.StoreKey), hub, node.CLIContext())
+	nsgrpc.RegisterQueryServer(server, srv)
+	nsgrpc.RegisterTxServer(server, srv)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := gogrpc.Dial(listener.Addr().String(), gogrpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+
+	if got := status.Code(err); got != want {
+		t.Fatalf("got %v (%v), want %v", got, err, want)
+	}
+}
+
+func TestServerAgainstNode(t *testing.T) {
+	node, err := app.StartTestNode(nameservice.DefaultGenesisState())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn := serve(t, ctx, node)
+	query, tx := nsgrpc.NewQueryClient(conn), nsgrpc.NewTxClient(conn)
+
+	// Unregistered And Invalid Names
+	_, err = query.WhoIs(ctx, &nsgrpc.WhoIsRequest{Name: "alice"})
+	assertCode(t, err, codes.NotFound)
+	_, err = query.Resolve(ctx, &nsgrpc.ResolveRequest{Name: "not a name!"})
+	assertCode(t, err, codes.InvalidArgument)
+
+	// A Signed Nameservice Tx Is Broadcast And Its Name Changes Returned
+	bz, err := node.Tx(nameservice.NewMsgBuyName("alice", sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10)), node.Account))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := tx.BroadcastTx(ctx, &nsgrpc.BroadcastTxRequest{Tx: bz, Mode: nsgrpc.BroadcastModeBlock})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Code != 0 || len(res.Events) != 1 || res.Events[0].Type != types.EventTypeRegisterName || res.Events[0].Name != "alice" {
+		t.Fatalf("broadcast got %+v, want alice's registration", res)
+	}
+
+	whois, err := query.WhoIs(ctx, &nsgrpc.WhoIsRequest{Name: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if whois.Owner != node.Account.String() {
+		t.Errorf("alice is owned by %s, want %s", whois.Owner, node.Account)
+	}
+
+	// Other Modules' Txs Are Refused, Unbroadcast
+	send := bank.NewMsgSend(node.Account, node.Account, sdk.NewCoins(sdk.NewInt64Coin("nametoken", 1)))
+	if bz, err = node.Tx(send); err != nil {
+		t.Fatal(err)
+	}
+	_, err = tx.BroadcastTx(ctx, &nsgrpc.BroadcastTxRequest{Tx: bz, Mode: nsgrpc.BroadcastModeBlock})
+	assertCode(t, err, codes.InvalidArgument)
+
+	_, err = tx.BroadcastTx(ctx, &nsgrpc.BroadcastTxRequest{Tx: []byte("not a tx"), Mode: nsgrpc.BroadcastModeBlock})
+	assertCode(t, err, codes.InvalidArgument)
+
+	// A Stream's Error Arrives With Its First Receive
+	stream, err := query.Watch(ctx, &nsgrpc.WatchRequest{Owner: "not an address"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = stream.Recv()
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+func TestServerWatch(t *testing.T) {
+	node, err := app.StartTestNode(nameservice.DefaultGenesisState())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	query := nsgrpc.NewQueryClient(serve(t, ctx, node))
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10))
+	if err := node.Deliver(
+		nameservice.NewMsgBuyName("alice", price, node.Account),
+		nameservice.NewMsgBuyName("bob", price, node.Account),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := query.Watch(ctx, &nsgrpc.WatchRequest{Name: "alice"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The Stream Opens Asynchronously, So Keep Changing Alice Until It Reports A Change
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case <-done:
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			// Bob Changes Too, But Isn't Watched
+			value := fmt.Sprintf("10.0.0.%d", i%250+1)
+			node.Deliver(
+				nameservice.NewMsgSetName("bob", value, node.Account),
+				nameservice.NewMsgSetName("alice", value, node.Account),
+			)
+		}
+	}()
+
+	event, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != types.EventTypeSetName || event.Name != "alice" || event.Height == 0 || event.TxHash == "" {
+		t.Fatalf("got %+v, want a change of alice's value", event)
+	}
+}
@@ -0,0 +1,218 @@
+package grpc
+
+import (
+	"context"
+
+	gogrpc "google.golang.org/grpc"
+)
+
+// Full Service Names Of nameservice.proto
+const (
+	queryService = "nameservice.v1.Query"
+	txService    = "nameservice.v1.Tx"
+)
+
+// QueryServer is the server API of the Query service
+type QueryServer interface {
+	Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error)
+	WhoIs(context.Context, *WhoIsRequest) (*WhoIsResponse, error)
+	Names(context.Context, *NamesRequest) (*NamesResponse, error)
+	Owned(context.Context, *OwnedRequest) (*OwnedResponse, error)
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	Watch(*WatchRequest, Query_WatchServer) error
+}
+
+// Query_WatchServer is the server side of a Watch stream
+type Query_WatchServer interface { // nolint: golint
+	Send(*NameEvent) error
+	gogrpc.ServerStream
+}
+
+// TxServer is the server API of the Tx service
+type TxServer interface {
+	BroadcastTx(context.Context, *BroadcastTxRequest) (*BroadcastTxResponse, error)
+}
+
+// RegisterQueryServer registers srv as the Query service of s
+func RegisterQueryServer(s *gogrpc.Server, srv QueryServer) {
+	s.RegisterService(&queryServiceDesc, srv)
+}
+
+// RegisterTxServer registers srv as the Tx service of s
+func RegisterTxServer(s *gogrpc.Server, srv TxServer) {
+	s.RegisterService(&txServiceDesc, srv)
+}
+
+// unary adapts a typed unary method to a grpc method handler
+func unary(service, method string, newReq func() interface{}, call func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error)) gogrpc.MethodDesc {
+	return gogrpc.MethodDesc{
+		MethodName: method,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor gogrpc.UnaryServerInterceptor) (interface{}, error) {
+			req := newReq()
+			if err := dec(req); err != nil {
+				return nil, err
+			}
+
+			if interceptor == nil {
+				return call(srv, ctx, req)
+			}
+
+			info := &gogrpc.UnaryServerInfo{Server: srv, FullMethod: "/" + service + "/" + method}
+			return interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv, ctx, req)
+			})
+		},
+	}
+}
+
+var queryServiceDesc = gogrpc.ServiceDesc{
+	ServiceName: queryService,
+	HandlerType: (*QueryServer)(nil),
+	Methods: []gogrpc.MethodDesc{
+		unary(queryService, "Resolve", func() interface{} { return new(ResolveRequest) }, func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.(QueryServer).Resolve(ctx, req.(*ResolveRequest))
+		}),
+		unary(queryService, "WhoIs", func() interface{} { return new(WhoIsRequest) }, func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.(QueryServer).WhoIs(ctx, req.(*WhoIsRequest))
+		}),
+		unary(queryService, "Names", func() interface{} { return new(NamesRequest) }, func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.(QueryServer).Names(ctx, req.(*NamesRequest))
+		}),
+		unary(queryService, "Owned", func() interface{} { return new(OwnedRequest) }, func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.(QueryServer).Owned(ctx, req.(*OwnedRequest))
+		}),
+		unary(queryService, "Params", func() interface{} { return new(ParamsRequest) }, func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+		}),
+	},
+	Streams: []gogrpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			ServerStreams: true,
+			Handler: func(srv interface{}, stream gogrpc.ServerStream) error {
+				req := new(WatchRequest)
+				if err := stream.RecvMsg(req); err != nil {
+					return err
+				}
+				return srv.(QueryServer).Watch(req, &queryWatchServer{stream})
+			},
+		},
+	},
+	Metadata: "nameservice.proto",
+}
+
+type queryWatchServer struct {
+	gogrpc.ServerStream
+}
+
+func (s *queryWatchServer) Send(event *NameEvent) error {
+	return s.ServerStream.SendMsg(event)
+}
+
+var txServiceDesc = gogrpc.ServiceDesc{
+	ServiceName: txService,
+	HandlerType: (*TxServer)(nil),
+	Methods: []gogrpc.MethodDesc{
+		unary(txService, "BroadcastTx", func() interface{} { return new(BroadcastTxRequest) }, func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.(TxServer).BroadcastTx(ctx, req.(*BroadcastTxRequest))
+		}),
+	},
+	Metadata: "nameservice.proto",
+}
+
+// QueryClient is the client API of the Query service
+type QueryClient interface {
+	Resolve(ctx context.Context, in *ResolveRequest, opts ...gogrpc.CallOption) (*ResolveResponse, error)
+	WhoIs(ctx context.Context, in *WhoIsRequest, opts ...gogrpc.CallOption) (*WhoIsResponse, error)
+	Names(ctx context.Context, in *NamesRequest, opts ...gogrpc.CallOption) (*NamesResponse, error)
+	Owned(ctx context.Context, in *OwnedRequest, opts ...gogrpc.CallOption) (*OwnedResponse, error)
+	Params(ctx context.Context, in *ParamsRequest, opts ...gogrpc.CallOption) (*ParamsResponse, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...gogrpc.CallOption) (Query_WatchClient, error)
+}
+
+// Query_WatchClient is the client side of a Watch stream
+type Query_WatchClient interface { // nolint: golint
+	Recv() (*NameEvent, error)
+	gogrpc.ClientStream
+}
+
+type queryClient struct {
+	cc *gogrpc.ClientConn
+}
+
+// NewQueryClient creates a QueryClient over cc
+func NewQueryClient(cc *gogrpc.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Resolve(ctx context.Context, in *ResolveRequest, opts ...gogrpc.CallOption) (*ResolveResponse, error) {
+	out := new(ResolveResponse)
+	return out, c.cc.Invoke(ctx, "/"+queryService+"/Resolve", in, out, opts...)
+}
+
+func (c *queryClient) WhoIs(ctx context.Context, in *WhoIsRequest, opts ...gogrpc.CallOption) (*WhoIsResponse, error) {
+	out := new(WhoIsResponse)
+	return out, c.cc.Invoke(ctx, "/"+queryService+"/WhoIs", in, out, opts...)
+}
+
+func (c *queryClient) Names(ctx context.Context, in *NamesRequest, opts ...gogrpc.CallOption) (*NamesResponse, error) {
+	out := new(NamesResponse)
+	return out, c.cc.Invoke(ctx, "/"+queryService+"/Names", in, out, opts...)
+}
+
+func (c *queryClient) Owned(ctx context.Context, in *OwnedRequest, opts ...gogrpc.CallOption) (*OwnedResponse, error) {
+	out := new(OwnedResponse)
+	return out, c.cc.Invoke(ctx, "/"+queryService+"/Owned", in, out, opts...)
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...gogrpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	return out, c.cc.Invoke(ctx, "/"+queryService+"/Params", in, out, opts...)
+}
+
+func (c *queryClient) Watch(ctx context.Context, in *WatchRequest, opts ...gogrpc.CallOption) (Query_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &queryServiceDesc.Streams[0], "/"+queryService+"/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	return &queryWatchClient{stream}, nil
+}
+
+type queryWatchClient struct {
+	gogrpc.ClientStream
+}
+
+func (c *queryWatchClient) Recv() (*NameEvent, error) {
+	event := new(NameEvent)
+	if err := c.ClientStream.RecvMsg(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// TxClient is the client API of the Tx service
+type TxClient interface {
+	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...gogrpc.CallOption) (*BroadcastTxResponse, error)
+}
+
+type txClient struct {
+	cc *gogrpc.ClientConn
+}
+
+// NewTxClient creates a TxClient over cc
+func NewTxClient(cc *gogrpc.ClientConn) TxClient {
+	return &txClient{cc}
+}
+
+func (c *txClient) BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...gogrpc.CallOption) (*BroadcastTxResponse, error) {
+	out := new(BroadcastTxResponse)
+	return out, c.cc.Invoke(ctx, "/"+txService+"/BroadcastTx", in, out, opts...)
+}
@@ -0,0 +1,221 @@
+package grpc
+
+import (
+	"github.com/golang/protobuf/proto"
+)
+
+// The messages of nameservice.proto, written by hand to match what
+// protoc-gen-go would produce: golang/protobuf encodes them from their struct
+// tags, so they are wire compatible with clients generated from the .proto.
+// Keep field numbers and tags in step with it.
+
+// BroadcastMode selects how long BroadcastTx waits for a tx
+type BroadcastMode int32
+
+// BroadcastMode values
+const (
+	BroadcastModeBlock BroadcastMode = 0
+	BroadcastModeSync  BroadcastMode = 1
+	BroadcastModeAsync BroadcastMode = 2
+)
+
+var broadcastModeNames = map[int32]string{
+	0: "BROADCAST_MODE_BLOCK",
+	1: "BROADCAST_MODE_SYNC",
+	2: "BROADCAST_MODE_ASYNC",
+}
+
+func (m BroadcastMode) String() string {
+	return proto.EnumName(broadcastModeNames, int32(m))
+}
+
+// Coin is the nameservice.v1.Coin message
+type Coin struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *Coin) Reset()         { *m = Coin{} }
+func (m *Coin) String() string { return proto.CompactTextString(m) }
+func (*Coin) ProtoMessage()    {}
+
+// Record is the nameservice.v1.Record message
+type Record struct {
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	TTL   uint32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (m *Record) Reset()         { *m = Record{} }
+func (m *Record) String() string { return proto.CompactTextString(m) }
+func (*Record) ProtoMessage()    {}
+
+// ResolveRequest is the nameservice.v1.ResolveRequest message
+type ResolveRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *ResolveRequest) Reset()         { *m = ResolveRequest{} }
+func (m *ResolveRequest) String() string { return proto.CompactTextString(m) }
+func (*ResolveRequest) ProtoMessage()    {}
+
+// ResolveResponse is the nameservice.v1.ResolveResponse message
+type ResolveResponse struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *ResolveResponse) Reset()         { *m = ResolveResponse{} }
+func (m *ResolveResponse) String() string { return proto.CompactTextString(m) }
+func (*ResolveResponse) ProtoMessage()    {}
+
+// WhoIsRequest is the nameservice.v1.WhoIsRequest message
+type WhoIsRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *WhoIsRequest) Reset()         { *m = WhoIsRequest{} }
+func (m *WhoIsRequest) String() string { return proto.CompactTextString(m) }
+func (*WhoIsRequest) ProtoMessage()    {}
+
+// WhoIsResponse is the nameservice.v1.WhoIsResponse message
+type WhoIsResponse struct {
+	Name    string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value   string    `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Owner   string    `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Price   []*Coin   `protobuf:"bytes,4,rep,name=price,proto3" json:"price,omitempty"`
+	Records []*Record `protobuf:"bytes,5,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *WhoIsResponse) Reset()         { *m = WhoIsResponse{} }
+func (m *WhoIsResponse) String() string { return proto.CompactTextString(m) }
+func (*WhoIsResponse) ProtoMessage()    {}
+
+// NamesRequest is the nameservice.v1.NamesRequest message
+type NamesRequest struct {
+}
+
+func (m *NamesRequest) Reset()         { *m = NamesRequest{} }
+func (m *NamesRequest) String() string { return proto.CompactTextString(m) }
+func (*NamesRequest) ProtoMessage()    {}
+
+// NamesResponse is the nameservice.v1.NamesResponse message
+type NamesResponse struct {
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *NamesResponse) Reset()         { *m = NamesResponse{} }
+func (m *NamesResponse) String() string { return proto.CompactTextString(m) }
+func (*NamesResponse) ProtoMessage()    {}
+
+// OwnedRequest is the nameservice.v1.OwnedRequest message
+type OwnedRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *OwnedRequest) Reset()         { *m = OwnedRequest{} }
+func (m *OwnedRequest) String() string { return proto.CompactTextString(m) }
+func (*OwnedRequest) ProtoMessage()    {}
+
+// OwnedResponse is the nameservice.v1.OwnedResponse message
+type OwnedResponse struct {
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *OwnedResponse) Reset()         { *m = OwnedResponse{} }
+func (m *OwnedResponse) String() string { return proto.CompactTextString(m) }
+func (*OwnedResponse) ProtoMessage()    {}
+
+// ParamsRequest is the nameservice.v1.ParamsRequest message
+type ParamsRequest struct {
+}
+
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+
+// ParamsResponse is the nameservice.v1.ParamsResponse message
+type ParamsResponse struct {
+	MinNamePrice     []*Coin  `protobuf:"bytes,1,rep,name=min_name_price,json=minNamePrice,proto3" json:"min_name_price,omitempty"`
+	MaxNameLength    uint32   `protobuf:"varint,2,opt,name=max_name_length,json=maxNameLength,proto3" json:"max_name_length,omitempty"`
+	DefaultRecordTTL uint32   `protobuf:"varint,3,opt,name=default_record_ttl,json=defaultRecordTtl,proto3" json:"default_record_ttl,omitempty"`
+	RecordTypes      []string `protobuf:"bytes,4,rep,name=record_types,json=recordTypes,proto3" json:"record_types,omitempty"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+
+// WatchRequest is the nameservice.v1.WatchRequest message
+type WatchRequest struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+
+// NameEvent is the nameservice.v1.NameEvent message
+type NameEvent struct {
+	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Owner         string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	PreviousOwner string `protobuf:"bytes,4,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	Value         string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Price         string `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Records       string `protobuf:"bytes,7,opt,name=records,proto3" json:"records,omitempty"`
+	Height        int64  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash        string `protobuf:"bytes,9,opt,name=txhash,proto3" json:"txhash,omitempty"`
+}
+
+func (m *NameEvent) Reset()         { *m = NameEvent{} }
+func (m *NameEvent) String() string { return proto.CompactTextString(m) }
+func (*NameEvent) ProtoMessage()    {}
+
+// BroadcastTxRequest is the nameservice.v1.BroadcastTxRequest message
+type BroadcastTxRequest struct {
+	Tx   []byte        `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Mode BroadcastMode `protobuf:"varint,2,opt,name=mode,proto3,enum=nameservice.v1.BroadcastMode" json:"mode,omitempty"`
+}
+
+func (m *BroadcastTxRequest) Reset()         { *m = BroadcastTxRequest{} }
+func (m *BroadcastTxRequest) String() string { return proto.CompactTextString(m) }
+func (*BroadcastTxRequest) ProtoMessage()    {}
+
+// BroadcastTxResponse is the nameservice.v1.BroadcastTxResponse message
+type BroadcastTxResponse struct {
+	TxHash string       `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	Height int64        `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Code   uint32       `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	RawLog string       `protobuf:"bytes,4,opt,name=raw_log,json=rawLog,proto3" json:"raw_log,omitempty"`
+	Events []*NameEvent `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (m *BroadcastTxResponse) Reset()         { *m = BroadcastTxResponse{} }
+func (m *BroadcastTxResponse) String() string { return proto.CompactTextString(m) }
+func (*BroadcastTxResponse) ProtoMessage()    {}
+
+func init() {
+	proto.RegisterEnum("nameservice.v1.BroadcastMode", broadcastModeNames, map[string]int32{
+		"BROADCAST_MODE_BLOCK": 0,
+		"BROADCAST_MODE_SYNC":  1,
+		"BROADCAST_MODE_ASYNC": 2,
+	})
+
+	proto.RegisterType((*Coin)(nil), "nameservice.v1.Coin")
+	proto.RegisterType((*Record)(nil), "nameservice.v1.Record")
+	proto.RegisterType((*ResolveRequest)(nil), "nameservice.v1.ResolveRequest")
+	proto.RegisterType((*ResolveResponse)(nil), "nameservice.v1.ResolveResponse")
+	proto.RegisterType((*WhoIsRequest)(nil), "nameservice.v1.WhoIsRequest")
+	proto.RegisterType((*WhoIsResponse)(nil), "nameservice.v1.WhoIsResponse")
+	proto.RegisterType((*NamesRequest)(nil), "nameservice.v1.NamesRequest")
+	proto.RegisterType((*NamesResponse)(nil), "nameservice.v1.NamesResponse")
+	proto.RegisterType((*OwnedRequest)(nil), "nameservice.v1.OwnedRequest")
+	proto.RegisterType((*OwnedResponse)(nil), "nameservice.v1.OwnedResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "nameservice.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "nameservice.v1.ParamsResponse")
+	proto.RegisterType((*WatchRequest)(nil), "nameservice.v1.WatchRequest")
+	proto.RegisterType((*NameEvent)(nil), "nameservice.v1.NameEvent")
+	proto.RegisterType((*BroadcastTxRequest)(nil), "nameservice.v1.BroadcastTxRequest")
+	proto.RegisterType((*BroadcastTxResponse)(nil), "nameservice.v1.BroadcastTxResponse")
+}
@@ -0,0 +1,237 @@
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// RegisterRoutes mounts the indexer's read-only API on r:
+//
+//	GET /status                 last indexed height
+//	GET /names                  ?owner=&created_after=&created_before=&order=name|price|updated&denom=&limit=&offset=
+//	GET /names/{name}           the name and its ownership history
+//	GET /names/{name}/history   its ownership history
+//	GET /sales                  ?name=&buyer=&seller=&limit=&offset=
+//	GET /sales/volume           ?from=YYYY-MM-DD&to=YYYY-MM-DD
+//
+// created_after and created_before take an RFC 3339 time or a duration back
+// from now, e.g. 168h, and select names by when their owner's account first
+// appeared on chain.
+func RegisterRoutes(store *Store, r *mux.Router) {
+	r.HandleFunc("/status", statusHandler(store)).Methods("GET")
+	r.HandleFunc("/names", namesHandler(store)).Methods("GET")
+	r.HandleFunc("/names/{name}", nameHandler(store)).Methods("GET")
+	r.HandleFunc("/names/{name}/history", historyHandler(store)).Methods("GET")
+	r.HandleFunc("/sales", salesHandler(store)).Methods("GET")
+	r.HandleFunc("/sales/volume", volumeHandler(store)).Methods("GET")
+}
+
+func statusHandler(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		height, _, err := store.LastHeight()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, map[string]int64{"last_height": height})
+	}
+}
+
+func namesHandler(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		limit, offset, err := parsePage(params.Get("limit"), params.Get("offset"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		q := NamesQuery{
+			Owner:   params.Get("owner"),
+			OrderBy: params.Get("order"),
+			Denom:   params.Get("denom"),
+			Limit:   limit,
+			Offset:  offset,
+		}
+
+		switch q.OrderBy {
+		case "", "name", "updated":
+		case "price":
+			if q.Denom == "" {
+				q.Denom = "nametoken"
+			}
+		default:
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown order %q", q.OrderBy))
+			return
+		}
+
+		if q.CreatedAfter, err = parseSince(params.Get("created_after")); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if q.CreatedBefore, err = parseSince(params.Get("created_before")); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		names, err := store.Names(q)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, names)
+	}
+}
+
+func nameHandler(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		n, found, err := store.Name(name)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if !found {
+			rest.WriteErrorResponse(w, http.StatusNotFound, fmt.Sprintf("%s is not registered", name))
+			return
+		}
+
+		history, err := store.History(name)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, struct {
+			Name
+			History []Transfer `json:"history"`
+		}{n, history})
+	}
+}
+
+func historyHandler(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		history, err := store.History(mux.Vars(r)["name"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, history)
+	}
+}
+
+func salesHandler(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+
+		limit, offset, err := parsePage(params.Get("limit"), params.Get("offset"))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		sales, err := store.Sales(SalesQuery{
+			Name:   params.Get("name"),
+			Buyer:  params.Get("buyer"),
+			Seller: params.Get("seller"),
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, sales)
+	}
+}
+
+func volumeHandler(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		from, to := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+
+		for _, day := range []string{from, to} {
+			if day == "" {
+				continue
+			}
+			if _, err := time.Parse("2006-01-02", day); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid day %q, expected YYYY-MM-DD", day))
+				return
+			}
+		}
+
+		volumes, err := store.Volume(from, to)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		writeJSON(w, volumes)
+	}
+}
+
+func parsePage(limitParam, offsetParam string) (int, int, error) {
+	limit, offset := defaultLimit, 0
+
+	if limitParam != "" {
+		value, err := strconv.Atoi(limitParam)
+		if err != nil || value <= 0 || value > maxLimit {
+			return 0, 0, fmt.Errorf("limit must be between 1 and %d", maxLimit)
+		}
+		limit = value
+	}
+
+	if offsetParam != "" {
+		value, err := strconv.Atoi(offsetParam)
+		if err != nil || value < 0 {
+			return 0, 0, fmt.Errorf("offset must not be negative")
+		}
+		offset = value
+	}
+
+	return limit, offset, nil
+}
+
+// parseSince accepts an RFC 3339 time, or a duration back from now, and
+// returns it in the RFC 3339 UTC form times are stored in
+func parseSince(param string) (string, error) {
+	if param == "" {
+		return "", nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, param); err == nil {
+		return t.UTC().Format(time.RFC3339), nil
+	}
+
+	d, err := time.ParseDuration(strings.TrimPrefix(param, "-"))
+	if err != nil {
+		return "", fmt.Errorf("invalid time %q, expected RFC 3339 or a duration such as 168h", param)
+	}
+
+	return time.Now().Add(-d).UTC().Format(time.RFC3339), nil
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
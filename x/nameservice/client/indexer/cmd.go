@@ -0,0 +1,106 @@
+package indexer
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	clicontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+const (
+	flagDB           = "db"
+	flagListenAddr   = "laddr"
+	flagPollInterval = "poll-interval"
+)
+
+// IndexerCommand returns the indexer command, which follows a node into a
+// SQLite database and serves it over a read-only HTTP API
+func IndexerCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer",
+		Short: "Index names, ownership history and sales into SQLite and serve them over HTTP",
+		Long: `Follow the blocks of a node from height 1, recording every name, each change of
+its owner and every sale in a SQLite database, and serve the database over a
+read-only HTTP API. Each block is applied in a single database transaction, so a
+restarted indexer resumes from the first block it hadn't finished. Tendermint
+blocks are final once committed, so indexed blocks are never revisited.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := clicontext.NewCLIContext().WithCodec(cdc)
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "indexer")
+
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				return err
+			}
+
+			store, err := OpenStore(viper.GetString(flagDB))
+			if err != nil {
+				return err
+			}
+			defer store.Close()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			go func() {
+				sigs := make(chan os.Signal, 1)
+				signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+				<-sigs
+				cancel()
+			}()
+
+			r := mux.NewRouter()
+			RegisterRoutes(store, r)
+			server := &http.Server{Addr: viper.GetString(flagListenAddr), Handler: r}
+
+			serveErr := make(chan error, 1)
+			go func() {
+				logger.Info("Serving indexer API", "laddr", server.Addr)
+				serveErr <- server.ListenAndServe()
+			}()
+
+			followErr := make(chan error, 1)
+			go func() {
+				followErr <- NewFollower(node, store, viper.GetDuration(flagPollInterval), logger).Run(ctx)
+			}()
+
+			select {
+			case err = <-serveErr:
+				cancel()
+				<-followErr
+			case err = <-followErr:
+				server.Close() // nolint: errcheck
+			case <-ctx.Done():
+				shutdownCtx, done := context.WithTimeout(context.Background(), 5*time.Second)
+				defer done()
+				server.Shutdown(shutdownCtx) // nolint: errcheck
+				err = <-followErr
+			}
+
+			if err == http.ErrServerClosed {
+				return nil
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().String(flagDB, "nameservice-index.db", "Path of the SQLite database, created if missing")
+	cmd.Flags().String(flagListenAddr, "localhost:8080", "The address to serve the HTTP API on")
+	cmd.Flags().Duration(flagPollInterval, time.Second, "How often to check for new blocks once caught up")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+
+	return cmd
+}
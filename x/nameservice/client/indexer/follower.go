@@ -0,0 +1,256 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Follower applies the nameservice events of every committed block to a Store
+type Follower struct {
+	node         rpcclient.Client
+	store        *Store
+	pollInterval time.Duration
+	logger       log.Logger
+}
+
+// NewFollower creates a Follower indexing node into store, checking for new
+// blocks every pollInterval once caught up
+func NewFollower(node rpcclient.Client, store *Store, pollInterval time.Duration, logger log.Logger) *Follower {
+	return &Follower{
+		node:         node,
+		store:        store,
+		pollInterval: pollInterval,
+		logger:       logger,
+	}
+}
+
+// Run indexes from the block after the last one stored up to the chain's
+// latest, then follows new blocks until ctx is cancelled. Tendermint blocks
+// are final once committed, so blocks are never revisited.
+func (f *Follower) Run(ctx context.Context) error {
+	last, indexed, err := f.store.LastHeight()
+	if err != nil {
+		return err
+	}
+
+	if !indexed {
+		if err := f.indexGenesis(); err != nil {
+			return fmt.Errorf("failed to index genesis: %w", err)
+		}
+	}
+
+	f.logger.Info("Indexing", "from", last+1)
+
+	for {
+		status, err := f.node.Status()
+		if err != nil {
+			f.logger.Error("Failed to get node status", "err", err)
+		}
+
+		for height := last + 1; err == nil && height <= status.SyncInfo.LatestBlockHeight; height++ {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			if err = f.indexBlock(height); err != nil {
+				f.logger.Error("Failed to index block", "height", height, "err", err)
+				break
+			}
+
+			last = height
+			if height%1000 == 0 {
+				f.logger.Info("Indexed", "height", height, "latest", status.SyncInfo.LatestBlockHeight)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(f.pollInterval):
+		}
+	}
+}
+
+// genesisAccounts is the part of the auth genesis state the indexer reads,
+// without needing the app's codec for the account types
+type genesisAccounts struct {
+	Accounts []struct {
+		Value struct {
+			Address string `json:"address"`
+		} `json:"value"`
+	} `json:"accounts"`
+}
+
+// indexGenesis stores the accounts and names present at genesis, which no
+// event announces
+func (f *Follower) indexGenesis() error {
+	genesis, err := f.node.Genesis()
+	if err != nil {
+		return err
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(genesis.Genesis.AppState, &appState); err != nil {
+		return err
+	}
+
+	var genState types.GenesisState
+	if bz, ok := appState[types.ModuleName]; ok {
+		if err := types.ModuleCdc.UnmarshalJSON(bz, &genState); err != nil {
+			return err
+		}
+	}
+
+	var authState genesisAccounts
+	if bz, ok := appState[auth.ModuleName]; ok {
+		if err := json.Unmarshal(bz, &authState); err != nil {
+			return err
+		}
+	}
+
+	b, err := f.store.begin(0, genesis.Genesis.GenesisTime)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range authState.Accounts {
+		if err := seeAddresses(b, account.Value.Address); err != nil {
+			b.rollback()
+			return err
+		}
+	}
+
+	for _, record := range genState.WhoIsRecords {
+		owner := record.WhoIs.Owner.String()
+		if err := b.transferName(types.EventTypeRegisterName, record.Name, owner, "", record.WhoIs.Price.String(), "", false); err != nil {
+			b.rollback()
+			return err
+		}
+		if err := b.setValue(record.Name, record.WhoIs.Value); err != nil {
+			b.rollback()
+			return err
+		}
+		if err := b.setRecords(record.Name, len(record.WhoIs.Records)); err != nil {
+			b.rollback()
+			return err
+		}
+		if err := b.seeAccount(owner); err != nil {
+			b.rollback()
+			return err
+		}
+	}
+
+	return b.commit()
+}
+
+func (f *Follower) indexBlock(height int64) error {
+	block, err := f.node.Block(&height)
+	if err != nil {
+		return err
+	}
+
+	b, err := f.store.begin(height, block.Block.Time)
+	if err != nil {
+		return err
+	}
+
+	if err := f.applyBlock(b, block.Block, height); err != nil {
+		b.rollback()
+		return err
+	}
+
+	return b.commit()
+}
+
+func (f *Follower) applyBlock(b *blockTx, block *tmtypes.Block, height int64) error {
+	// Empty Blocks Still Advance The Indexed Height, Without Fetching Results
+	if len(block.Data.Txs) == 0 {
+		return nil
+	}
+
+	results, err := f.node.BlockResults(&height)
+	if err != nil {
+		return err
+	}
+
+	for i, result := range results.TxsResults {
+		// Failed Txs Changed Nothing
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+
+		txHash := fmt.Sprintf("%X", block.Data.Txs[i].Hash())
+		for _, event := range result.Events {
+			if err := applyEvent(b, event, txHash); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, event := range results.EndBlockEvents {
+		if err := applyEvent(b, event, ""); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyEvent(b *blockTx, event abci.Event, txHash string) error {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+
+	name := attrs[types.AttributeKeyName]
+
+	switch event.Type {
+	case types.EventTypeRegisterName, types.EventTypeBuyName:
+		return b.transferName(event.Type, name, attrs[types.AttributeKeyOwner], attrs[types.AttributeKeyPreviousOwner], attrs[types.AttributeKeyPrice], txHash, true)
+
+	case types.EventTypeSetName:
+		return b.setValue(name, attrs[types.AttributeKeyValue])
+
+	case types.EventTypeSetRecords:
+		records, _ := strconv.Atoi(attrs[types.AttributeKeyRecords])
+		return b.setRecords(name, records)
+
+	case types.EventTypeDeleteName:
+		return b.deleteName(name, attrs[types.AttributeKeyOwner], txHash)
+
+	// Accounts Are Created When They First Send Or Receive Coins
+	case bank.EventTypeTransfer:
+		return seeAddresses(b, attrs[bank.AttributeKeyRecipient])
+
+	case sdk.EventTypeMessage:
+		return seeAddresses(b, attrs[sdk.AttributeKeySender])
+	}
+
+	return nil
+}
+
+func seeAddresses(b *blockTx, addresses ...string) error {
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+		if err := b.seeAccount(address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package indexer_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/indexer"
+)
+
+// follow indexes node into the database at path until it has caught up
+// with the node's latest block, then stops
+func follow(t *testing.T, node *app.TestNode, path string) {
+	t.Helper()
+
+	status, err := node.Client.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := indexer.OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- indexer.NewFollower(node.Client, store, 10*time.Millisecond, log.NewNopLogger()).Run(ctx)
+	}()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	for i := 0; i < 500; i++ {
+		if height, _, err := store.LastHeight(); err == nil && height >= status.SyncInfo.LatestBlockHeight {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("the indexer didn't reach height %d", status.SyncInfo.LatestBlockHeight)
+}
+
+// get serves path from the indexer API over the database at dbPath, decoding
+// the response into out
+func get(t *testing.T, dbPath, path string, out interface{}) int {
+	t.Helper()
+
+	store, err := indexer.OpenStore(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	r := mux.NewRouter()
+	indexer.RegisterRoutes(store, r)
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+
+	if w.Code == http.StatusOK && out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+	}
+	return w.Code
+}
+
+func TestIndexerFollowsNode(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nameservice-indexer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, "index.db")
+
+	// A Name Registered At Genesis, Which No Event Announces
+	genState := nameservice.DefaultGenesisState()
+	genOwner := sdk.AccAddress("genesis-owner-addr--")
+	genState.WhoIsRecords = []nameservice.GenesisWhoIs{{
+		Name:  "genesis",
+		WhoIs: nameservice.WhoIs{Value: "10.0.0.9", Owner: genOwner, Price: sdk.NewCoins(sdk.NewInt64Coin("nametoken", 1))},
+	}}
+
+	node, err := app.StartTestNode(genState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10))
+	if err := node.Deliver(nameservice.NewMsgBuyName("alice", price, node.Account)); err != nil {
+		t.Fatal(err)
+	}
+	follow(t, node, dbPath)
+
+	// The Indexer Picks Up Where It Stopped
+	if err := node.Deliver(nameservice.NewMsgSetName("alice", "10.0.0.1", node.Account)); err != nil {
+		t.Fatal(err)
+	}
+	follow(t, node, dbPath)
+
+	var alice struct {
+		indexer.Name
+		History []indexer.Transfer `json:"history"`
+	}
+	if code := get(t, dbPath, "/names/alice", &alice); code != http.StatusOK {
+		t.Fatalf("got status %d for alice", code)
+	}
+	if alice.Owner != node.Account.String() || alice.Value != "10.0.0.1" || alice.Price != price.String() {
+		t.Errorf("alice is indexed as %+v", alice.Name)
+	}
+	if len(alice.History) != 1 || alice.History[0].Owner != node.Account.String() || alice.History[0].TxHash == "" {
+		t.Errorf("alice's history is %+v, want its registration", alice.History)
+	}
+
+	var genesis indexer.Name
+	if code := get(t, dbPath, "/names/genesis", &genesis); code != http.StatusOK || genesis.Owner != genOwner.String() || genesis.Value != "10.0.0.9" {
+		t.Errorf("got status %d and %+v for the genesis name", code, genesis)
+	}
+
+	// The Registration Is Recorded Once, However Often The Indexer Restarted
+	var sales []indexer.Sale
+	get(t, dbPath, "/sales?name=alice", &sales)
+	if len(sales) != 1 || sales[0].Buyer != node.Account.String() || sales[0].Seller != "" {
+		t.Errorf("alice's sales are %+v, want its registration alone", sales)
+	}
+
+	var owned []indexer.Name
+	get(t, dbPath, "/names?owner="+node.Account.String(), &owned)
+	if len(owned) != 1 || owned[0].Name != "alice" {
+		t.Errorf("the node's account owns %+v, want alice", owned)
+	}
+
+	if code := get(t, dbPath, "/names/bob", nil); code != http.StatusNotFound {
+		t.Errorf("got status %d for an unregistered name, want 404", code)
+	}
+	if code := get(t, dbPath, "/names?order=owner", nil); code != http.StatusBadRequest {
+		t.Errorf("got status %d for an unknown order, want 400", code)
+	}
+	if code := get(t, dbPath, "/sales/volume?from=yesterday", nil); code != http.StatusBadRequest {
+		t.Errorf("got status %d for a malformed day, want 400", code)
+	}
+}
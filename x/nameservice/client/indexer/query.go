@@ -0,0 +1,232 @@
+package indexer
+
+import (
+	"database/sql"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Name is an indexed name as it currently stands
+type Name struct {
+	Name             string `json:"name"`
+	Owner            string `json:"owner"`
+	Value            string `json:"value"`
+	Price            string `json:"price"`
+	Records          int    `json:"records"`
+	RegisteredHeight int64  `json:"registered_height"`
+	RegisteredTime   string `json:"registered_time"`
+	UpdatedHeight    int64  `json:"updated_height"`
+	UpdatedTime      string `json:"updated_time"`
+}
+
+// Transfer is a change of a name's owner. Deletions have no new owner.
+type Transfer struct {
+	Name          string `json:"name"`
+	Owner         string `json:"owner"`
+	PreviousOwner string `json:"previous_owner"`
+	Event         string `json:"event"`
+	Height        int64  `json:"height"`
+	Time          string `json:"time"`
+	TxHash        string `json:"txhash"`
+}
+
+// Sale is a name bought on chain. Registrations of unowned names have no
+// seller.
+type Sale struct {
+	Name   string `json:"name"`
+	Buyer  string `json:"buyer"`
+	Seller string `json:"seller"`
+	Price  string `json:"price"`
+	Height int64  `json:"height"`
+	Time   string `json:"time"`
+	TxHash string `json:"txhash"`
+}
+
+// DayVolume is the number and total value of the sales made in a (UTC) day
+type DayVolume struct {
+	Day    string    `json:"day"`
+	Sales  int       `json:"sales"`
+	Volume sdk.Coins `json:"volume"`
+}
+
+// NamesQuery selects and orders names
+type NamesQuery struct {
+	Owner string
+	// Accounts First Seen In [CreatedAfter, CreatedBefore), RFC 3339
+	CreatedAfter  string
+	CreatedBefore string
+	// price (With Denom), name Or updated
+	OrderBy string
+	Denom   string
+	Limit   int
+	Offset  int
+}
+
+const nameColumns = `n.name, n.owner, n.value, n.price, n.records, n.registered_height, n.registered_time, n.updated_height, n.updated_time`
+
+// Names returns the names matching q
+func (s *Store) Names(q NamesQuery) ([]Name, error) {
+	var where []string
+	var args []interface{}
+
+	from := `names n`
+	if q.CreatedAfter != "" || q.CreatedBefore != "" {
+		from = `names n JOIN accounts a ON a.address = n.owner`
+		if q.CreatedAfter != "" {
+			where = append(where, `a.first_seen_time >= ?`)
+			args = append(args, q.CreatedAfter)
+		}
+		if q.CreatedBefore != "" {
+			where = append(where, `a.first_seen_time < ?`)
+			args = append(args, q.CreatedBefore)
+		}
+	}
+
+	if q.Owner != "" {
+		where = append(where, `n.owner = ?`)
+		args = append(args, q.Owner)
+	}
+
+	order := `n.name`
+	switch q.OrderBy {
+	case "price":
+		where = append(where, `n.price_denom = ?`)
+		args = append(args, q.Denom)
+		order = `n.price_amount DESC, n.name`
+	case "updated":
+		order = `n.updated_height DESC, n.name`
+	}
+
+	query := `SELECT ` + nameColumns + ` FROM ` + from
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, ` AND `)
+	}
+	query += ` ORDER BY ` + order + ` LIMIT ? OFFSET ?`
+	args = append(args, q.Limit, q.Offset)
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	names := []Name{}
+	for rows.Next() {
+		var n Name
+		if err := rows.Scan(&n.Name, &n.Owner, &n.Value, &n.Price, &n.Records, &n.RegisteredHeight, &n.RegisteredTime, &n.UpdatedHeight, &n.UpdatedTime); err != nil {
+			return nil, err
+		}
+		names = append(names, n)
+	}
+
+	return names, rows.Err()
+}
+
+// Name returns a single name, or false if it isn't currently registered
+func (s *Store) Name(name string) (Name, bool, error) {
+	var n Name
+	err := s.db.QueryRow(`SELECT `+nameColumns+` FROM names n WHERE n.name = ?`, name).
+		Scan(&n.Name, &n.Owner, &n.Value, &n.Price, &n.Records, &n.RegisteredHeight, &n.RegisteredTime, &n.UpdatedHeight, &n.UpdatedTime)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return Name{}, false, nil
+	case err != nil:
+		return Name{}, false, err
+	}
+
+	return n, true, nil
+}
+
+// History returns every change of owner of name, oldest first
+func (s *Store) History(name string) ([]Transfer, error) {
+	rows, err := s.db.Query(
+		`SELECT name, owner, previous_owner, event, height, time, tx_hash FROM ownership WHERE name = ? ORDER BY id`,
+		name,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := []Transfer{}
+	for rows.Next() {
+		var t Transfer
+		if err := rows.Scan(&t.Name, &t.Owner, &t.PreviousOwner, &t.Event, &t.Height, &t.Time, &t.TxHash); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	}
+
+	return transfers, rows.Err()
+}
+
+// SalesQuery selects sales; empty fields match anything
+type SalesQuery struct {
+	Name   string
+	Buyer  string
+	Seller string
+	Limit  int
+	Offset int
+}
+
+// Sales returns the sales matching q, newest first
+func (s *Store) Sales(q SalesQuery) ([]Sale, error) {
+	rows, err := s.db.Query(`
+		SELECT name, buyer, seller, price, height, time, tx_hash FROM sales
+		WHERE (? = '' OR name = ?) AND (? = '' OR buyer = ?) AND (? = '' OR seller = ?)
+		ORDER BY id DESC LIMIT ? OFFSET ?`,
+		q.Name, q.Name, q.Buyer, q.Buyer, q.Seller, q.Seller, q.Limit, q.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sales := []Sale{}
+	for rows.Next() {
+		var sale Sale
+		if err := rows.Scan(&sale.Name, &sale.Buyer, &sale.Seller, &sale.Price, &sale.Height, &sale.Time, &sale.TxHash); err != nil {
+			return nil, err
+		}
+		sales = append(sales, sale)
+	}
+
+	return sales, rows.Err()
+}
+
+// Volume returns the sales volume of each day in [from, to], both given as
+// YYYY-MM-DD and optional. Prices are summed exactly, per denom.
+func (s *Store) Volume(from, to string) ([]DayVolume, error) {
+	rows, err := s.db.Query(`
+		SELECT day, price FROM sales
+		WHERE (? = '' OR day >= ?) AND (? = '' OR day <= ?)
+		ORDER BY day, id`,
+		from, from, to, to,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	volumes := []DayVolume{}
+	for rows.Next() {
+		var day, price string
+		if err := rows.Scan(&day, &price); err != nil {
+			return nil, err
+		}
+
+		if len(volumes) == 0 || volumes[len(volumes)-1].Day != day {
+			volumes = append(volumes, DayVolume{Day: day, Volume: sdk.NewCoins()})
+		}
+
+		current := &volumes[len(volumes)-1]
+		current.Sales++
+		if coins, err := sdk.ParseCoins(price); err == nil {
+			current.Volume = current.Volume.Add(coins...)
+		}
+	}
+
+	return volumes, rows.Err()
+}
@@ -0,0 +1,239 @@
+package indexer
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	// SQLite Driver, Registered As "sqlite3"
+	_ "github.com/mattn/go-sqlite3"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// schema is applied on every start; each statement must be idempotent
+const schema = `
+CREATE TABLE IF NOT EXISTS meta (
+	key   TEXT PRIMARY KEY,
+	value TEXT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS names (
+	name              TEXT PRIMARY KEY,
+	owner             TEXT NOT NULL,
+	value             TEXT NOT NULL DEFAULT '',
+	price             TEXT NOT NULL DEFAULT '',
+	price_denom       TEXT NOT NULL DEFAULT '',
+	price_amount      REAL NOT NULL DEFAULT 0,
+	records           INTEGER NOT NULL DEFAULT 0,
+	registered_height INTEGER NOT NULL,
+	registered_time   TEXT NOT NULL,
+	updated_height    INTEGER NOT NULL,
+	updated_time      TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS names_owner ON names (owner);
+CREATE INDEX IF NOT EXISTS names_price ON names (price_denom, price_amount);
+
+CREATE TABLE IF NOT EXISTS ownership (
+	id             INTEGER PRIMARY KEY AUTOINCREMENT,
+	name           TEXT NOT NULL,
+	owner          TEXT NOT NULL,
+	previous_owner TEXT NOT NULL DEFAULT '',
+	event          TEXT NOT NULL,
+	height         INTEGER NOT NULL,
+	time           TEXT NOT NULL,
+	tx_hash        TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS ownership_name ON ownership (name, id);
+CREATE INDEX IF NOT EXISTS ownership_owner ON ownership (owner);
+
+CREATE TABLE IF NOT EXISTS sales (
+	id           INTEGER PRIMARY KEY AUTOINCREMENT,
+	name         TEXT NOT NULL,
+	buyer        TEXT NOT NULL,
+	seller       TEXT NOT NULL DEFAULT '',
+	price        TEXT NOT NULL,
+	price_denom  TEXT NOT NULL DEFAULT '',
+	price_amount REAL NOT NULL DEFAULT 0,
+	height       INTEGER NOT NULL,
+	time         TEXT NOT NULL,
+	day          TEXT NOT NULL,
+	tx_hash      TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS sales_day ON sales (day);
+CREATE INDEX IF NOT EXISTS sales_name ON sales (name);
+
+CREATE TABLE IF NOT EXISTS accounts (
+	address           TEXT PRIMARY KEY,
+	first_seen_height INTEGER NOT NULL,
+	first_seen_time   TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS accounts_first_seen ON accounts (first_seen_time);
+`
+
+// Meta Key Holding The Last Fully Indexed Height
+const metaLastHeight = "last_height"
+
+// Store is the indexer's SQLite database
+type Store struct {
+	db *sql.DB
+}
+
+// OpenStore opens (creating if needed) the database at path
+func OpenStore(path string) (*Store, error) {
+	// One Writer At A Time; WAL Lets The API Read While Blocks Are Applied
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?_journal_mode=WAL&_busy_timeout=5000&_foreign_keys=on", path))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to apply schema: %w", err)
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close closes the database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// LastHeight returns the last height fully indexed, 0 being genesis, and
+// whether anything was indexed yet
+func (s *Store) LastHeight() (int64, bool, error) {
+	var value string
+	err := s.db.QueryRow(`SELECT value FROM meta WHERE key = ?`, metaLastHeight).Scan(&value)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	height, err := strconv.ParseInt(value, 10, 64)
+	return height, err == nil, err
+}
+
+// blockTx applies the changes of a single block. Everything a block changes,
+// including the last indexed height, commits together, so a restart resumes
+// from the first block not fully applied.
+type blockTx struct {
+	tx     *sql.Tx
+	height int64
+	time   string
+}
+
+func (s *Store) begin(height int64, blockTime time.Time) (*blockTx, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockTx{tx: tx, height: height, time: blockTime.UTC().Format(time.RFC3339)}, nil
+}
+
+func (b *blockTx) commit() error {
+	_, err := b.tx.Exec(
+		`INSERT INTO meta (key, value) VALUES (?, ?) ON CONFLICT (key) DO UPDATE SET value = excluded.value`,
+		metaLastHeight, strconv.FormatInt(b.height, 10),
+	)
+	if err != nil {
+		b.tx.Rollback() // nolint: errcheck
+		return err
+	}
+
+	return b.tx.Commit()
+}
+
+func (b *blockTx) rollback() {
+	b.tx.Rollback() // nolint: errcheck
+}
+
+// seeAccount records the first block an address appeared in
+func (b *blockTx) seeAccount(address string) error {
+	_, err := b.tx.Exec(
+		`INSERT INTO accounts (address, first_seen_height, first_seen_time) VALUES (?, ?, ?) ON CONFLICT (address) DO NOTHING`,
+		address, b.height, b.time,
+	)
+	return err
+}
+
+// transferName records name passing to owner, by registration or purchase.
+// Names given out at genesis weren't sold, so aren't recorded as sales.
+func (b *blockTx) transferName(event, name, owner, previousOwner, price, txHash string, sale bool) error {
+	denom, amount := priceKey(price)
+
+	_, err := b.tx.Exec(`
+		INSERT INTO names (name, owner, price, price_denom, price_amount, registered_height, registered_time, updated_height, updated_time)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (name) DO UPDATE SET
+			owner = excluded.owner, price = excluded.price, price_denom = excluded.price_denom,
+			price_amount = excluded.price_amount, updated_height = excluded.updated_height, updated_time = excluded.updated_time`,
+		name, owner, price, denom, amount, b.height, b.time, b.height, b.time,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.tx.Exec(
+		`INSERT INTO ownership (name, owner, previous_owner, event, height, time, tx_hash) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		name, owner, previousOwner, event, b.height, b.time, txHash,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !sale {
+		return nil
+	}
+
+	_, err = b.tx.Exec(
+		`INSERT INTO sales (name, buyer, seller, price, price_denom, price_amount, height, time, day, tx_hash) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		name, owner, previousOwner, price, denom, amount, b.height, b.time, b.time[:len("2006-01-02")], txHash,
+	)
+	return err
+}
+
+func (b *blockTx) setValue(name, value string) error {
+	_, err := b.tx.Exec(
+		`UPDATE names SET value = ?, updated_height = ?, updated_time = ? WHERE name = ?`,
+		value, b.height, b.time, name,
+	)
+	return err
+}
+
+func (b *blockTx) setRecords(name string, records int) error {
+	_, err := b.tx.Exec(
+		`UPDATE names SET records = ?, updated_height = ?, updated_time = ? WHERE name = ?`,
+		records, b.height, b.time, name,
+	)
+	return err
+}
+
+// deleteName drops the name, keeping its ownership and sales history
+func (b *blockTx) deleteName(name, owner, txHash string) error {
+	if _, err := b.tx.Exec(`DELETE FROM names WHERE name = ?`, name); err != nil {
+		return err
+	}
+
+	_, err := b.tx.Exec(
+		`INSERT INTO ownership (name, owner, previous_owner, event, height, time, tx_hash) VALUES (?, '', ?, 'delete_name', ?, ?, ?)`,
+		name, owner, b.height, b.time, txHash,
+	)
+	return err
+}
+
+// priceKey returns the denom and (approximate) amount prices are ordered by:
+// the first coin of the price
+func priceKey(price string) (string, float64) {
+	coins, err := sdk.ParseCoins(price)
+	if err != nil || coins.Empty() {
+		return "", 0
+	}
+
+	amount, _ := strconv.ParseFloat(coins[0].Amount.String(), 64)
+	return coins[0].Denom, amount
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+var _ Client = (*MockClient)(nil)
+
+// MockClient is an in-memory Client for testing code built on this package.
+// It answers like a node would, including its errors.
+type MockClient struct {
+	mtx   sync.RWMutex
+	names map[string]types.WhoIs
+}
+
+// NewMockClient creates an empty MockClient
+func NewMockClient() *MockClient {
+	return &MockClient{names: make(map[string]types.WhoIs)}
+}
+
+// SetWhoIs stores whois under name
+func (m *MockClient) SetWhoIs(name string, whois types.WhoIs) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	m.names[name] = whois
+}
+
+// DeleteWhoIs removes name
+func (m *MockClient) DeleteWhoIs(name string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	delete(m.names, name)
+}
+
+// Resolve implements Client
+func (m *MockClient) Resolve(ctx context.Context, name string) (string, error) {
+	whois, err := m.WhoIs(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	if len(whois.Value) == 0 {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Couldn't Resolve Name")
+	}
+
+	return whois.Value, nil
+}
+
+// WhoIs implements Client
+func (m *MockClient) WhoIs(ctx context.Context, name string) (types.WhoIs, error) {
+	if err := ctx.Err(); err != nil {
+		return types.WhoIs{}, err
+	}
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	whois, ok := m.names[name]
+	if !ok {
+		return types.WhoIs{}, sdkerrors.Wrap(types.ErrNameDoesNotExist, name)
+	}
+
+	return whois, nil
+}
+
+// Records implements Client
+func (m *MockClient) Records(ctx context.Context, name string) ([]types.Record, error) {
+	whois, err := m.WhoIs(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return whois.Records, nil
+}
+
+// Address implements Client
+func (m *MockClient) Address(ctx context.Context, name string) (sdk.AccAddress, error) {
+	whois, err := m.WhoIs(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	address := whois.PaymentAddress()
+	if address.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s has no owner", name)
+	}
+
+	return address, nil
+}
+
+// Names implements Client
+func (m *MockClient) Names(ctx context.Context) ([]string, error) {
+	return m.filter(ctx, func(types.WhoIs) bool { return true })
+}
+
+// Owned implements Client
+func (m *MockClient) Owned(ctx context.Context, owner sdk.AccAddress) ([]string, error) {
+	return m.filter(ctx, func(whois types.WhoIs) bool { return whois.Owner.Equals(owner) })
+}
+
+// OwnedBy mirrors NodeClient.OwnedBy
+func (m *MockClient) OwnedBy(ctx context.Context, owners []sdk.AccAddress) (map[string][]string, error) {
+	ownedBy := make(map[string][]string, len(owners))
+	for _, owner := range owners {
+		names, err := m.Owned(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		ownedBy[owner.String()] = names
+	}
+
+	return ownedBy, nil
+}
+
+// filter returns the names whose whois matches, in store (byte) order
+func (m *MockClient) filter(ctx context.Context, match func(types.WhoIs) bool) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	names := []string{}
+	for name, whois := range m.names {
+		if match(whois) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	return names, nil
+}
@@ -0,0 +1,83 @@
+package resolver
+
+import (
+	"container/list"
+	"time"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// entry is the cached answer for a name. Missing names are cached too, so
+// repeated lookups of a typo don't each reach the node.
+type entry struct {
+	name    string
+	whois   types.WhoIs
+	found   bool
+	fetched time.Time
+}
+
+// lru is a fixed size least-recently-used map of names to entries. It is not
+// safe for concurrent use; the Resolver serialises access to it.
+type lru struct {
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newLRU(size int) *lru {
+	return &lru{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *lru) get(name string) (*entry, bool) {
+	elem, ok := c.entries[name]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(elem)
+	return elem.Value.(*entry), true
+}
+
+// add stores e, returning whether the least recently used entry was evicted
+// to make room for it
+func (c *lru) add(e *entry) bool {
+	if elem, ok := c.entries[e.name]; ok {
+		elem.Value = e
+		c.order.MoveToFront(elem)
+		return false
+	}
+
+	c.entries[e.name] = c.order.PushFront(e)
+	if c.order.Len() <= c.size {
+		return false
+	}
+
+	oldest := c.order.Back()
+	c.order.Remove(oldest)
+	delete(c.entries, oldest.Value.(*entry).name)
+	return true
+}
+
+func (c *lru) remove(name string) bool {
+	elem, ok := c.entries[name]
+	if !ok {
+		return false
+	}
+
+	c.order.Remove(elem)
+	delete(c.entries, name)
+	return true
+}
+
+func (c *lru) purge() {
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+func (c *lru) len() int {
+	return c.order.Len()
+}
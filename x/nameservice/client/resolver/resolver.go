@@ -0,0 +1,390 @@
+// Package resolver is a caching nameservice resolver for services that look
+// names up far more often than they change. Answers are kept in an LRU cache
+// and dropped as soon as a nameservice tx touching the name is committed;
+// while no event subscription is up they expire after a fixed TTL instead.
+package resolver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+const (
+	// DefaultSize is the number of names cached when no size is given
+	DefaultSize = 10000
+
+	// DefaultTTL is how long answers are trusted while events aren't flowing
+	DefaultTTL = 30 * time.Second
+
+	// Subscriber Name Used On The Tendermint Event Bus
+	subscriber = "nameservice-resolver"
+
+	// Matches Every Tx Carrying A nameservice Message
+	eventQuery = "tm.event = 'Tx' AND message.module = 'nameservice'"
+
+	// Matches Every Block, Whose End-Block Events Carry What The EndBlocker Settled
+	blockQuery = "tm.event = 'NewBlock'"
+)
+
+// Events Whose name Attribute Invalidates A Cached Answer
+var invalidatingEvents = []string{
+	types.EventTypeRegisterName,
+	types.EventTypeBuyName,
+	types.EventTypeSetName,
+	types.EventTypeDeleteName,
+	types.EventTypeSetRecords,
+	types.EventTypeSetOwners,
+	types.EventTypeExecuteProposal,
+	types.EventTypeRecoverName,
+	types.EventTypeSelfAssess,
+	types.EventTypeDepositTax,
+	types.EventTypeWithdrawTax,
+	types.EventTypePayTax,
+	types.EventTypeForecloseName,
+	types.EventTypeEndAuction,
+}
+
+var _ nsclient.Client = (*Resolver)(nil)
+
+// Stats are the resolver's cache counters since it was created
+type Stats struct {
+	Hits          uint64 `json:"hits"`
+	Misses        uint64 `json:"misses"`
+	Evictions     uint64 `json:"evictions"`
+	Invalidations uint64 `json:"invalidations"`
+	Entries       int    `json:"entries"`
+	Subscribed    bool   `json:"subscribed"`
+}
+
+// HitRate is the share of lookups answered from the cache
+func (s Stats) HitRate() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Resolver is a nsclient.Client caching per-name answers in front of another
+// Client. Names and Owned span every name and are passed straight through.
+type Resolver struct {
+	backend nsclient.Client
+	ttl     time.Duration
+	logger  log.Logger
+
+	mtx        sync.Mutex
+	cache      *lru
+	inflight   map[string]*call
+	generation uint64
+	subscribed bool
+	stats      Stats
+}
+
+// call is a backend lookup shared by every caller missing the same name
+type call struct {
+	done  chan struct{}
+	entry *entry
+	err   error
+}
+
+// New creates a Resolver caching up to size names looked up through backend,
+// trusting them for ttl while it isn't watching events
+func New(backend nsclient.Client, size int, ttl time.Duration) *Resolver {
+	if size <= 0 {
+		size = DefaultSize
+	}
+
+	return &Resolver{
+		backend:  backend,
+		ttl:      ttl,
+		logger:   log.NewNopLogger(),
+		cache:    newLRU(size),
+		inflight: make(map[string]*call),
+	}
+}
+
+// WithLogger sets the logger subscription changes are reported to
+func (r *Resolver) WithLogger(logger log.Logger) *Resolver {
+	r.logger = logger
+	return r
+}
+
+// Stats returns a snapshot of the cache counters
+func (r *Resolver) Stats() Stats {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	stats := r.stats
+	stats.Entries = r.cache.len()
+	stats.Subscribed = r.subscribed
+	return stats
+}
+
+// Invalidate drops the cached answers for names
+func (r *Resolver) Invalidate(names ...string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.generation++
+	for _, name := range names {
+		if r.cache.remove(name) {
+			r.stats.Invalidations++
+		}
+	}
+}
+
+// Purge drops every cached answer
+func (r *Resolver) Purge() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.generation++
+	r.stats.Invalidations += uint64(r.cache.len())
+	r.cache.purge()
+}
+
+// Resolve implements nsclient.Client
+func (r *Resolver) Resolve(ctx context.Context, name string) (string, error) {
+	whois, err := r.WhoIs(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	// Matches The Node's resolve Query
+	if len(whois.Value) == 0 {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Couldn't Resolve Name")
+	}
+
+	return whois.Value, nil
+}
+
+// WhoIs implements nsclient.Client
+func (r *Resolver) WhoIs(ctx context.Context, name string) (types.WhoIs, error) {
+	e, err := r.lookup(ctx, name)
+	if err != nil {
+		return types.WhoIs{}, err
+	}
+
+	if !e.found {
+		return types.WhoIs{}, sdkerrors.Wrap(types.ErrNameDoesNotExist, name)
+	}
+
+	return e.whois, nil
+}
+
+// Records implements nsclient.Client
+func (r *Resolver) Records(ctx context.Context, name string) ([]types.Record, error) {
+	whois, err := r.WhoIs(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return whois.Records, nil
+}
+
+// Address implements nsclient.Client
+func (r *Resolver) Address(ctx context.Context, name string) (sdk.AccAddress, error) {
+	whois, err := r.WhoIs(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	address := whois.PaymentAddress()
+	if address.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s has no owner", name)
+	}
+
+	return address, nil
+}
+
+// Names implements nsclient.Client
+func (r *Resolver) Names(ctx context.Context) ([]string, error) {
+	return r.backend.Names(ctx)
+}
+
+// Owned implements nsclient.Client
+func (r *Resolver) Owned(ctx context.Context, owner sdk.AccAddress) ([]string, error) {
+	return r.backend.Owned(ctx, owner)
+}
+
+// lookup returns the cached entry for name, fetching it on a miss. Concurrent
+// misses for a name share a single backend query.
+func (r *Resolver) lookup(ctx context.Context, name string) (*entry, error) {
+	r.mtx.Lock()
+
+	if e, ok := r.cache.get(name); ok && r.fresh(e, time.Now()) {
+		r.stats.Hits++
+		r.mtx.Unlock()
+		return e, nil
+	}
+
+	r.stats.Misses++
+
+	if c, ok := r.inflight[name]; ok {
+		r.mtx.Unlock()
+		return c.wait(ctx)
+	}
+
+	c := &call{done: make(chan struct{})}
+	r.inflight[name] = c
+	generation := r.generation
+	r.mtx.Unlock()
+
+	c.entry, c.err = r.fetch(ctx, name)
+
+	r.mtx.Lock()
+	delete(r.inflight, name)
+
+	// An Invalidation While The Query Ran May Mean The Answer Is Already Stale
+	if c.err == nil && r.generation == generation {
+		if r.cache.add(c.entry) {
+			r.stats.Evictions++
+		}
+	}
+	r.mtx.Unlock()
+
+	close(c.done)
+	return c.entry, c.err
+}
+
+// fresh reports whether e can still be served. While subscribed, entries live
+// until an event invalidates them; otherwise they expire after the TTL.
+func (r *Resolver) fresh(e *entry, now time.Time) bool {
+	return r.subscribed || now.Sub(e.fetched) < r.ttl
+}
+
+func (r *Resolver) fetch(ctx context.Context, name string) (*entry, error) {
+	e := &entry{name: name, found: true, fetched: time.Now()}
+
+	whois, err := r.backend.WhoIs(ctx, name)
+	switch {
+	case types.ErrNameDoesNotExist.Is(err):
+		e.found = false
+	case err != nil:
+		return nil, err
+	default:
+		e.whois = whois
+	}
+
+	return e, nil
+}
+
+func (c *call) wait(ctx context.Context) (*entry, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		return c.entry, c.err
+	}
+}
+
+// Watch subscribes to nameservice events on node, those of txs and those the
+// EndBlocker emits, and invalidates the names they touch until ctx is
+// cancelled, resubscribing with backoff whenever the subscription drops.
+// Answers fall back to TTL expiry while it is down.
+func (r *Resolver) Watch(ctx context.Context, node rpcclient.Client) {
+	if !node.IsRunning() {
+		if err := node.Start(); err != nil {
+			r.logger.Error("Event invalidation disabled", "err", err)
+			return
+		}
+		defer node.Stop() // nolint: errcheck
+	}
+
+	backoff := time.Second
+	for {
+		txs, blocks, err := subscribe(ctx, node)
+		if err == nil {
+			// Anything Cached Before The Subscription May Have Missed Events
+			r.setSubscribed(true)
+			r.logger.Info("Subscribed to nameservice events")
+
+			r.consumeEvents(ctx, txs, blocks)
+			r.setSubscribed(false)
+			node.UnsubscribeAll(context.Background(), subscriber) // nolint: errcheck
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			r.logger.Error("Nameservice event subscription dropped")
+			backoff = time.Second
+			continue
+		}
+
+		r.logger.Error("Failed to subscribe to nameservice events", "err", err, "retry", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		if backoff < time.Minute {
+			backoff *= 2
+		}
+	}
+}
+
+// subscribe subscribes to nameservice txs and to new blocks, whose end-block
+// events carry the names the EndBlocker forecloses, awards or taxes
+func subscribe(ctx context.Context, node rpcclient.Client) (txs, blocks <-chan ctypes.ResultEvent, err error) {
+	txs, err = node.Subscribe(ctx, subscriber, eventQuery)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blocks, err = node.Subscribe(ctx, subscriber, blockQuery)
+	if err != nil {
+		node.Unsubscribe(context.Background(), subscriber, eventQuery) // nolint: errcheck
+		return nil, nil, err
+	}
+
+	return txs, blocks, nil
+}
+
+func (r *Resolver) setSubscribed(subscribed bool) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if subscribed {
+		r.generation++
+		r.stats.Invalidations += uint64(r.cache.len())
+		r.cache.purge()
+	}
+	r.subscribed = subscribed
+}
+
+func (r *Resolver) consumeEvents(ctx context.Context, txs, blocks <-chan ctypes.ResultEvent) {
+	for {
+		var event ctypes.ResultEvent
+		var ok bool
+
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok = <-txs:
+		case event, ok = <-blocks:
+		}
+
+		if !ok {
+			return
+		}
+
+		for _, eventType := range invalidatingEvents {
+			if names := event.Events[eventType+"."+types.AttributeKeyName]; len(names) > 0 {
+				r.Invalidate(names...)
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package resolver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/resolver"
+)
+
+// eventually polls cond until it holds, failing with msg if it never does
+func eventually(t *testing.T, msg string, cond func() bool) {
+	t.Helper()
+
+	for i := 0; !cond(); i++ {
+		if i == 500 {
+			t.Fatal(msg)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestResolverAgainstNode(t *testing.T) {
+	// Long Enough To Cache A Taxed Name Before The EndBlocker Forecloses It
+	genState := nameservice.DefaultGenesisState()
+	genState.Params.TaxPeriod = 20
+
+	node, err := app.StartTestNode(genState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10))
+	if err := node.Deliver(
+		nameservice.NewMsgBuyName("alice", price, node.Account),
+		nameservice.NewMsgSetName("alice", "10.0.0.1", node.Account),
+		nameservice.NewMsgBuyName("bob", price, node.Account),
+		nameservice.NewMsgSetName("bob", "10.0.0.2", node.Account),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	// Answers Are Trusted For An Hour Without Events, Longer Than The Test Runs
+	res := resolver.New(nsclient.NewClient(node.Client).WithQueryRoute(nameservice.StoreKey), 0, time.Hour)
+
+	watchCtx, stopWatching := context.WithCancel(ctx)
+	watched := make(chan struct{})
+	go func() {
+		res.Watch(watchCtx, node.Client)
+		close(watched)
+	}()
+	eventually(t, "the resolver never subscribed", func() bool { return res.Stats().Subscribed })
+
+	resolve := func(name, want string) {
+		t.Helper()
+
+		if got, err := res.Resolve(ctx, name); err != nil || got != want {
+			t.Fatalf("%s resolves to %q (%v), want %q", name, got, err, want)
+		}
+	}
+
+	resolve("alice", "10.0.0.1")
+	resolve("bob", "10.0.0.2")
+	resolve("alice", "10.0.0.1")
+	if stats := res.Stats(); stats.Hits != 1 || stats.Misses != 2 || stats.Entries != 2 {
+		t.Fatalf("got %+v, want alice's second lookup answered from the cache", stats)
+	}
+
+	// A Tx Touching Bob Drops Bob's Answer, And Only Bob's
+	if err := node.Deliver(nameservice.NewMsgSetName("bob", "10.0.0.3", node.Account)); err != nil {
+		t.Fatal(err)
+	}
+	eventually(t, "bob's cached answer was never invalidated", func() bool {
+		got, err := res.Resolve(ctx, "bob")
+		return err == nil && got == "10.0.0.3"
+	})
+
+	misses := res.Stats().Misses
+	resolve("alice", "10.0.0.1")
+	if stats := res.Stats(); stats.Misses != misses {
+		t.Errorf("alice was fetched again after a tx touching bob alone")
+	}
+
+	// The EndBlocker's Events Invalidate Too: A Harberger Name With No Deposit Is Foreclosed
+	if err := node.Deliver(nameservice.NewMsgSelfAssess("bob", sdk.NewCoins(sdk.NewInt64Coin("nametoken", 1000)), node.Account)); err != nil {
+		t.Fatal(err)
+	}
+	resolve("bob", "10.0.0.3")
+
+	eventually(t, "bob's cached answer outlived its foreclosure", func() bool {
+		_, err := res.WhoIs(ctx, "bob")
+		return nsclient.ErrNameDoesNotExist.Is(err)
+	})
+
+	// Without The Subscription, Answers Stay Cached For The TTL Whatever Happens
+	stopWatching()
+	<-watched
+	if res.Stats().Subscribed {
+		t.Fatal("the resolver still reports a subscription")
+	}
+
+	if err := node.Deliver(nameservice.NewMsgSetName("alice", "10.0.0.4", node.Account)); err != nil {
+		t.Fatal(err)
+	}
+	resolve("alice", "10.0.0.1")
+
+	res.Invalidate("alice")
+	resolve("alice", "10.0.0.4")
+}
@@ -0,0 +1,83 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+func TestCacheExpiresWithoutSubscription(t *testing.T) {
+	backend := nsclient.NewMockClient()
+	backend.SetWhoIs("alice", types.WhoIs{Value: "10.0.0.1"})
+
+	r := New(backend, 0, time.Minute)
+	resolve(t, r, "alice", "10.0.0.1")
+
+	backend.SetWhoIs("alice", types.WhoIs{Value: "10.0.0.2"})
+	resolve(t, r, "alice", "10.0.0.1")
+
+	if stats := r.Stats(); stats.Hits != 1 || stats.Misses != 1 {
+		t.Fatalf("got %d hits and %d misses, want 1 of each", stats.Hits, stats.Misses)
+	}
+
+	// Past The TTL, The Answer Is Fetched Again
+	r.ttl = 0
+	resolve(t, r, "alice", "10.0.0.2")
+}
+
+// While Subscribed, Answers Are Served Until Events From Txs Or The EndBlocker Invalidate Them
+func TestEventsInvalidateCache(t *testing.T) {
+	backend := nsclient.NewMockClient()
+	for _, name := range []string{"alice", "bob", "carol"} {
+		backend.SetWhoIs(name, types.WhoIs{Value: "10.0.0.1"})
+	}
+
+	r := New(backend, 0, 0)
+	r.setSubscribed(true)
+
+	for _, name := range []string{"alice", "bob", "carol"} {
+		resolve(t, r, name, "10.0.0.1")
+		backend.SetWhoIs(name, types.WhoIs{Value: "10.0.0.2"})
+		resolve(t, r, name, "10.0.0.1")
+	}
+
+	txs := make(chan ctypes.ResultEvent)
+	blocks := make(chan ctypes.ResultEvent)
+
+	done := make(chan struct{})
+	go func() {
+		r.consumeEvents(context.Background(), txs, blocks)
+		close(done)
+	}()
+
+	txs <- ctypes.ResultEvent{Events: map[string][]string{
+		types.EventTypeSetOwners + "." + types.AttributeKeyName: {"alice"},
+	}}
+	blocks <- ctypes.ResultEvent{Events: map[string][]string{
+		types.EventTypeForecloseName + "." + types.AttributeKeyName: {"bob"},
+		types.EventTypeEndCooldown + "." + types.AttributeKeyName:   {"carol"},
+	}}
+	close(txs)
+	<-done
+
+	resolve(t, r, "alice", "10.0.0.2")
+	resolve(t, r, "bob", "10.0.0.2")
+	resolve(t, r, "carol", "10.0.0.1")
+}
+
+func resolve(t *testing.T, r *Resolver, name, want string) {
+	t.Helper()
+
+	got, err := r.Resolve(context.Background(), name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("%s resolved to %s, want %s", name, got, want)
+	}
+}
@@ -0,0 +1,211 @@
+package rest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/watch"
+)
+
+const (
+	// FlagSignAndBroadcast makes the tx routes sign with the local key named in
+	// base_req.from and broadcast, instead of returning the unsigned tx
+	FlagSignAndBroadcast = "sign-and-broadcast"
+
+	// FlagSignKeys lists the local keys the server may sign with. Requests
+	// naming any other key are refused.
+	FlagSignKeys = "sign-keys"
+
+	// FlagSignPassphraseFile names a file holding the keyring's passphrase,
+	// which the server can't prompt for
+	FlagSignPassphraseFile = "sign-passphrase-file"
+)
+
+// Query Parameter Selecting The Broadcast Mode
+const restMode = "mode"
+
+// RegisterServerFlags adds the flags the nameservice routes read to the REST
+// server command
+func RegisterServerFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Bool(FlagSignAndBroadcast, false, "Sign nameservice txs with the local key named in base_req.from and broadcast them (?mode=sync|async|block, default block)")
+	cmd.Flags().StringSlice(FlagSignKeys, nil, "Comma separated names of the local keys --sign-and-broadcast may sign with")
+	cmd.Flags().String(FlagSignPassphraseFile, "", "File holding the passphrase that unlocks a file keyring for --sign-and-broadcast")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+
+	return cmd
+}
+
+// broadcastResp is returned by the tx routes in sign-and-broadcast mode.
+// Events are only known once the tx is executed, in block mode.
+type broadcastResp struct {
+	TxHash string        `json:"txhash"`
+	Height int64         `json:"height,omitempty"`
+	Code   uint32        `json:"code,omitempty"`
+	RawLog string        `json:"raw_log,omitempty"`
+	Events []watch.Event `json:"events"`
+}
+
+// writeTxResponse answers a tx route with the unsigned tx, or with the result
+// of broadcasting it when the server signs
+func writeTxResponse(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, br rest.BaseReq, msgs []sdk.Msg) {
+	if !viper.GetBool(FlagSignAndBroadcast) {
+		utils.WriteGenerateStdTxResponse(w, cliCtx, br, msgs)
+		return
+	}
+
+	mode := r.URL.Query().Get(restMode)
+	switch mode {
+	case "":
+		mode = flags.BroadcastBlock
+	case flags.BroadcastSync, flags.BroadcastAsync, flags.BroadcastBlock:
+	default:
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid broadcast mode %q, expected sync, async or block", mode))
+		return
+	}
+
+	gasAdj, ok := rest.ParseFloat64OrReturnBadRequest(w, br.GasAdjustment, flags.DefaultGasAdjustment)
+	if !ok {
+		return
+	}
+
+	simAndExec, gas, err := flags.ParseGas(br.Gas)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	passphrase, err := signPassphrase()
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// The Server Has No Terminal, So A File Keyring Reads Its Passphrase From Here
+	keybase, err := keys.NewKeyring(sdk.KeyringServiceName(),
+		viper.GetString(flags.FlagKeyringBackend), viper.GetString(flags.FlagHome), strings.NewReader(passphrase+"\n"))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if br.From == "" {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "base_req.from must name the key to sign with")
+		return
+	}
+
+	info, err := signingKey(keybase, viper.GetStringSlice(FlagSignKeys), br.From)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusForbidden, err.Error())
+		return
+	}
+
+	// Signing With Another Key Would Only Fail On Chain
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(info.GetAddress()) {
+				rest.WriteErrorResponse(w, http.StatusBadRequest,
+					fmt.Sprintf("key %s (%s) can't sign for %s", info.GetName(), info.GetAddress(), signer))
+				return
+			}
+		}
+	}
+
+	ctx := cliCtx.
+		WithFromAddress(info.GetAddress()).
+		WithFromName(info.GetName()).
+		WithBroadcastMode(mode)
+
+	txBldr := authtypes.NewTxBuilder(
+		utils.GetTxEncoder(cliCtx.Codec), br.AccountNumber, br.Sequence, gas, gasAdj,
+		simAndExec, br.ChainID, br.Memo, br.Fees, br.GasPrices,
+	).WithKeybase(keybase)
+
+	// Account Number & Sequence Not Given Are Read From The Chain
+	txBldr, err = utils.PrepareTxBuilder(txBldr, ctx)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if simAndExec {
+		txBldr, err = utils.EnrichWithGas(txBldr, ctx, msgs)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	txBytes, err := txBldr.BuildAndSign(info.GetName(), passphrase, msgs)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	res, err := ctx.BroadcastTx(txBytes)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	events := watch.DecodeTxResponse(res)
+	if events == nil {
+		events = []watch.Event{}
+	}
+
+	rest.PostProcessResponseBare(w, cliCtx, broadcastResp{
+		TxHash: res.TxHash,
+		Height: res.Height,
+		Code:   res.Code,
+		RawLog: res.RawLog,
+		Events: events,
+	})
+}
+
+// signingKey looks up the key named or addressed by from among the keys the
+// server may sign with, so other keys in the keyring are never used or probed
+func signingKey(keybase keys.Keybase, allowed []string, from string) (keys.Info, error) {
+	for _, name := range allowed {
+		info, err := keybase.Get(name)
+		if err != nil {
+			return nil, fmt.Errorf("key %s allowed by --%s: %w", name, FlagSignKeys, err)
+		}
+
+		if info.GetName() == from || info.GetAddress().String() == from {
+			return info, nil
+		}
+	}
+
+	return nil, fmt.Errorf("the server doesn't sign with %s", from)
+}
+
+// signPassphrase reads the keyring passphrase from --sign-passphrase-file.
+// Only file keyrings need one.
+func signPassphrase() (string, error) {
+	path := viper.GetString(FlagSignPassphraseFile)
+	if path == "" {
+		if viper.GetString(flags.FlagKeyringBackend) == keys.BackendFile {
+			return "", fmt.Errorf("a file keyring needs --%s to sign with", FlagSignPassphraseFile)
+		}
+		return "", nil
+	}
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(bz), "\r\n"), nil
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+func newTestKeybase(t *testing.T, kb keys.Keybase, names ...string) map[string]keys.Info {
+	t.Helper()
+
+	infos := make(map[string]keys.Info, len(names))
+	for _, name := range names {
+		info, _, err := kb.CreateMnemonic(name, keys.English, "", keys.Secp256k1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		infos[name] = info
+	}
+	return infos
+}
+
+func TestSigningKey(t *testing.T) {
+	kb := keys.NewInMemory()
+	infos := newTestKeybase(t, kb, "relayer", "treasury")
+
+	allowed := []string{"relayer"}
+
+	for _, from := range []string{"relayer", infos["relayer"].GetAddress().String()} {
+		info, err := signingKey(kb, allowed, from)
+		if err != nil || info.GetName() != "relayer" {
+			t.Errorf("signingKey(%s) = %v, %v, want relayer", from, info, err)
+		}
+	}
+
+	// Keys In The Keyring But Not Allowed Are Refused Like Unknown Ones
+	for _, from := range []string{"treasury", infos["treasury"].GetAddress().String(), "nobody"} {
+		if _, err := signingKey(kb, allowed, from); err == nil {
+			t.Errorf("signingKey(%s) succeeded, want it refused", from)
+		}
+	}
+
+	if _, err := signingKey(kb, nil, "relayer"); err == nil {
+		t.Error("signingKey succeeded without any allowed keys")
+	}
+}
+
+func TestWriteTxResponseRefusesOtherKeys(t *testing.T) {
+	home, err := ioutil.TempDir("", "nameservice-rest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+
+	viper.Set(FlagSignAndBroadcast, true)
+	viper.Set(FlagSignKeys, []string{"relayer"})
+	viper.Set(flags.FlagKeyringBackend, keys.BackendTest)
+	viper.Set(flags.FlagHome, home)
+	defer viper.Reset()
+
+	// Keys Outside --sign-keys Are Forbidden, Whatever The Keyring Holds
+	for from, status := range map[string]int{"treasury": http.StatusForbidden, "": http.StatusBadRequest} {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest("POST", "/nameservice/names", nil)
+
+		writeTxResponse(w, r, context.CLIContext{}, rest.BaseReq{From: from, ChainID: "test"}, nil)
+		if w.Code != status {
+			t.Errorf("from %q: got status %d, want %d: %s", from, w.Code, status, w.Body)
+		}
+	}
+
+	// A File Keyring Can't Be Unlocked Without A Passphrase Source
+	viper.Set(flags.FlagKeyringBackend, keys.BackendFile)
+	w := httptest.NewRecorder()
+	writeTxResponse(w, httptest.NewRequest("POST", "/nameservice/names", nil), context.CLIContext{}, rest.BaseReq{From: "relayer"}, nil)
+	if w.Code != http.StatusInternalServerError || !strings.Contains(w.Body.String(), FlagSignPassphraseFile) {
+		t.Errorf("got status %d, want a missing --%s error: %s", w.Code, FlagSignPassphraseFile, w.Body)
+	}
+
+	passphraseFile := filepath.Join(home, "passphrase")
+	if err := ioutil.WriteFile(passphraseFile, []byte("correct horse\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	viper.Set(FlagSignPassphraseFile, passphraseFile)
+
+	if passphrase, err := signPassphrase(); err != nil || passphrase != "correct horse" {
+		t.Errorf("signPassphrase() = %q, %v, want the file's first line", passphrase, err)
+	}
+}
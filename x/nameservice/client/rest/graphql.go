@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	nsclient "github.com/arjunandra/nameservice-cosmos/x/nameservice/client"
+	nsgraphql "github.com/arjunandra/nameservice-cosmos/x/nameservice/client/graphql"
+)
+
+// graphqlHandler answers GraphQL queries over nameservice state. The API is
+// built on the first request, once the node is needed.
+func graphqlHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	var once sync.Once
+	var handler http.Handler
+	var handlerErr error
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() {
+			node, err := cliCtx.GetNode()
+			if err != nil {
+				handlerErr = err
+				return
+			}
+
+			client := nsclient.NewClient(node).WithQueryRoute(storeName).WithHeight(cliCtx.Height)
+			handler = nsgraphql.NewHandler(client, node)
+		})
+
+		if handlerErr != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, handlerErr.Error())
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	}
+}
@@ -0,0 +1,130 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/app"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/rest"
+)
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// postGraphQL posts query to the router's GraphQL route
+func postGraphQL(t *testing.T, r http.Handler, query string) graphqlResponse {
+	t.Helper()
+
+	body, _ := json.Marshal(map[string]string{"query": query})
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/nameservice/graphql", bytes.NewReader(body)))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("got status %d: %s", w.Code, w.Body)
+	}
+
+	var res graphqlResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+		t.Fatalf("%v: %s", err, w.Body)
+	}
+	return res
+}
+
+func TestGraphQLAgainstNode(t *testing.T) {
+	node, err := app.StartTestNode(nameservice.DefaultGenesisState())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer node.Stop()
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("nametoken", 10))
+	if err := node.Deliver(
+		nameservice.NewMsgBuyName("alice", price, node.Account),
+		nameservice.NewMsgSetName("alice", "10.0.0.1", node.Account),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	r := mux.NewRouter()
+	rest.RegisterRoutes(node.CLIContext(), r, "nameservice")
+
+	query := `{
+		name(name: "alice") {
+			value
+			owner { address names { totalCount } }
+			sales { buyer { address } seller { address } }
+		}
+		missing: name(name: "bob") { value }
+	}`
+
+	var data struct {
+		Name struct {
+			Value string
+			Owner struct {
+				Address string
+				Names   struct{ TotalCount int }
+			}
+			Sales []struct {
+				Buyer  struct{ Address string }
+				Seller *struct{ Address string }
+			}
+		}
+		Missing *struct{ Value string }
+	}
+
+	// Sales Come From The Tx Index, Which Trails The Commit A Little
+	for i := 0; ; i++ {
+		res := postGraphQL(t, r, query)
+		if len(res.Errors) > 0 {
+			t.Fatalf("query failed: %v", res.Errors)
+		}
+		if err := json.Unmarshal(res.Data, &data); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(data.Name.Sales) > 0 || i == 100 {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if data.Name.Value != "10.0.0.1" {
+		t.Errorf("alice resolves to %q, want 10.0.0.1", data.Name.Value)
+	}
+	if data.Name.Owner.Address != node.Account.String() || data.Name.Owner.Names.TotalCount != 1 {
+		t.Errorf("alice is owned by %+v, want the node's account owning one name", data.Name.Owner)
+	}
+	if len(data.Name.Sales) != 1 || data.Name.Sales[0].Buyer.Address != node.Account.String() || data.Name.Sales[0].Seller != nil {
+		t.Errorf("alice's sales are %+v, want its registration by the node's account", data.Name.Sales)
+	}
+	if data.Missing != nil {
+		t.Errorf("an unregistered name resolved to %+v, want null", data.Missing)
+	}
+
+	// A Malformed Query Is Answered With Errors, Not Data
+	res := postGraphQL(t, r, `{ name(name: "alice") { value `)
+	if len(res.Errors) == 0 {
+		t.Error("a malformed query got no errors")
+	}
+	if len(res.Data) > 0 && string(res.Data) != "null" {
+		t.Errorf("a malformed query got data %s", res.Data)
+	}
+
+	// As Is One Asking For Fields The Schema Lacks
+	if res := postGraphQL(t, r, `{ name(name: "alice") { password } }`); len(res.Errors) == 0 {
+		t.Error("a query for an unknown field got no errors")
+	}
+}
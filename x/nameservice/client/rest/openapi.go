@@ -0,0 +1,349 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// schema is a JSON Schema object of the OpenAPI document
+type schema map[string]interface{}
+
+func ref(name string) schema {
+	return schema{"$ref": "#/components/schemas/" + name}
+}
+
+func response(name string) schema {
+	return schema{"$ref": "#/components/responses/" + name}
+}
+
+func arrayOf(items schema) schema {
+	return schema{"type": "array", "items": items}
+}
+
+func str(description string) schema {
+	return schema{"type": "string", "description": description}
+}
+
+// Shared Components Of The OpenAPI Document
+var openAPIComponents = schema{
+	"schemas": schema{
+		"Coin": schema{
+			"type":     "object",
+			"required": []string{"denom", "amount"},
+			"properties": schema{
+				"denom":  str("Coin denomination"),
+				"amount": str("Integer amount, as a string"),
+			},
+		},
+		"DecCoin": schema{
+			"type": "object",
+			"properties": schema{
+				"denom":  str("Coin denomination"),
+				"amount": str("Decimal amount, as a string"),
+			},
+		},
+		"BaseReq": schema{
+			"type":     "object",
+			"required": []string{"from", "chain_id"},
+			"properties": schema{
+				"from":           str("Address of the signer; with --sign-and-broadcast, the name or address of one of the server's --sign-keys"),
+				"memo":           str("Tx memo"),
+				"chain_id":       str("Chain ID"),
+				"account_number": schema{"type": "string", "format": "uint64"},
+				"sequence":       schema{"type": "string", "format": "uint64"},
+				"fees":           arrayOf(ref("Coin")),
+				"gas_prices":     arrayOf(ref("DecCoin")),
+				"gas":            str(`Gas limit, or "auto" to simulate`),
+				"gas_adjustment": str("Factor the simulated gas is multiplied by"),
+				"simulate":       schema{"type": "boolean", "description": "Only estimate the gas"},
+			},
+		},
+		"BuyNameReq": schema{
+			"type":     "object",
+			"required": []string{"base_req", "name", "amount", "buyer"},
+			"properties": schema{
+				"base_req": ref("BaseReq"),
+				"name":     str("Name to buy"),
+				"amount":   str("Bid, e.g. 10nametoken"),
+				"buyer":    str("Buyer address"),
+			},
+		},
+		"SetNameReq": schema{
+			"type":     "object",
+			"required": []string{"base_req", "name", "value", "owner"},
+			"properties": schema{
+				"base_req": ref("BaseReq"),
+				"name":     str("Name to set"),
+				"value":    str("Value the name resolves to"),
+				"owner":    str("Owner address"),
+			},
+		},
+		"DeleteNameReq": schema{
+			"type":     "object",
+			"required": []string{"base_req", "name", "owner"},
+			"properties": schema{
+				"base_req": ref("BaseReq"),
+				"name":     str("Name to delete"),
+				"owner":    str("Owner address"),
+			},
+		},
+		"SendToNameReq": schema{
+			"type":     "object",
+			"required": []string{"base_req", "amount", "sender"},
+			"properties": schema{
+				"base_req": ref("BaseReq"),
+				"amount":   str("Coins to send, e.g. 10nametoken"),
+				"sender":   str("Sender address"),
+			},
+		},
+		"StdTx": schema{
+			"type":        "object",
+			"description": "Unsigned amino JSON StdTx, to be signed and broadcast by the client",
+			"properties": schema{
+				"type":  str(`"cosmos-sdk/StdTx"`),
+				"value": schema{"type": "object"},
+			},
+		},
+		"BroadcastResponse": schema{
+			"type":     "object",
+			"required": []string{"txhash", "events"},
+			"properties": schema{
+				"txhash":  str("Hex hash of the broadcast tx"),
+				"height":  schema{"type": "string", "format": "int64", "description": "Height the tx was committed at (block mode)"},
+				"code":    schema{"type": "integer", "description": "Non-zero when the tx failed"},
+				"raw_log": str("Tx log"),
+				"events":  arrayOf(ref("NameEvent")),
+			},
+		},
+		"NameEvent": schema{
+			"type":     "object",
+			"required": []string{"type", "name", "height", "txhash"},
+			"properties": schema{
+				"type":           schema{"type": "string", "enum": []string{"register_name", "buy_name", "set_name", "set_records", "delete_name", "set_owners", "execute_proposal", "recover_name", "self_assess", "deposit_tax", "withdraw_tax", "pay_tax", "foreclose_name", "end_auction"}},
+				"name":           str("Name changed"),
+				"owner":          str("Owner after the change"),
+				"previous_owner": str("Owner before a sale"),
+				"value":          str("New value"),
+				"price":          str("Price paid"),
+				"records":        str("Number of records set"),
+				"height":         schema{"type": "string", "format": "int64"},
+				"txhash":         str("Hex hash of the tx, empty for changes made by the EndBlocker"),
+			},
+		},
+		"Record": schema{
+			"type": "object",
+			"properties": schema{
+				"type":  schema{"type": "string", "enum": []string{"A", "AAAA", "TXT", "CNAME", "ADDR"}},
+				"value": str("Record value"),
+				"ttl":   schema{"type": "integer", "format": "uint32"},
+			},
+		},
+		"WhoIs": schema{
+			"type": "object",
+			"properties": schema{
+				"value":   str("Value the name resolves to"),
+				"owner":   str("Owner address"),
+				"price":   arrayOf(ref("Coin")),
+				"records": arrayOf(ref("Record")),
+			},
+		},
+		"ErrorResponse": schema{
+			"type":     "object",
+			"required": []string{"error"},
+			"properties": schema{
+				"code":  schema{"type": "integer"},
+				"error": str("Error message"),
+			},
+		},
+	},
+	"responses": schema{
+		"BadRequest": schema{
+			"description": "The request is malformed or fails validation",
+			"content":     jsonContent(ref("ErrorResponse")),
+		},
+		"NotFound": schema{
+			"description": "The name is not registered, or the node can't be reached",
+			"content":     jsonContent(ref("ErrorResponse")),
+		},
+		"InternalError": schema{
+			"description": "Signing, broadcasting or encoding failed",
+			"content":     jsonContent(ref("ErrorResponse")),
+		},
+		"Tx": schema{
+			"description": "The unsigned tx, or with --sign-and-broadcast the broadcast result",
+			"content": jsonContent(schema{
+				"oneOf": []schema{ref("StdTx"), ref("BroadcastResponse")},
+			}),
+		},
+	},
+	"parameters": schema{
+		"name": schema{
+			"name":     restName,
+			"in":       "path",
+			"required": true,
+			"schema":   schema{"type": "string"},
+		},
+		"mode": schema{
+			"name":        restMode,
+			"in":          "query",
+			"description": "Broadcast mode with --sign-and-broadcast; events are only returned in block mode",
+			"schema":      schema{"type": "string", "enum": []string{"sync", "async", "block"}, "default": "block"},
+		},
+	},
+}
+
+func jsonContent(s schema) schema {
+	return schema{"application/json": schema{"schema": s}}
+}
+
+// withHeight wraps a query result the way rest.PostProcessResponse does
+func withHeight(result schema) schema {
+	return jsonContent(schema{
+		"type": "object",
+		"properties": schema{
+			"height": schema{"type": "string", "format": "int64"},
+			"result": result,
+		},
+	})
+}
+
+func queryOp(id, summary string, result schema, params ...schema) schema {
+	op := schema{
+		"operationId": id,
+		"summary":     summary,
+		"responses": schema{
+			"200": schema{"description": "OK", "content": withHeight(result)},
+			"404": response("NotFound"),
+		},
+	}
+	if len(params) > 0 {
+		op["parameters"] = params
+	}
+	return op
+}
+
+func txOp(id, summary, req string, params ...schema) schema {
+	return schema{
+		"operationId": id,
+		"summary":     summary,
+		"parameters":  append(params, schema{"$ref": "#/components/parameters/mode"}),
+		"requestBody": schema{"required": true, "content": jsonContent(ref(req))},
+		"responses": schema{
+			"200": response("Tx"),
+			"400": response("BadRequest"),
+			"500": response("InternalError"),
+		},
+	}
+}
+
+var nameParam = schema{"$ref": "#/components/parameters/name"}
+
+// openAPISpec returns the OpenAPI 3 document of the routes RegisterRoutes
+// mounts under storeName
+func openAPISpec(storeName string) schema {
+	path := func(p string) string { return fmt.Sprintf("/%s%s", storeName, p) }
+
+	return schema{
+		"openapi": "3.0.3",
+		"info": schema{
+			"title":       "Nameservice REST API",
+			"description": "Routes served by acli rest-server for the nameservice module",
+			"version":     "1",
+		},
+		"paths": schema{
+			path("/names"): schema{
+				"get":    queryOp("listNames", "List every registered name", arrayOf(schema{"type": "string"})),
+				"post":   txOp("buyName", "Buy a name", "BuyNameReq"),
+				"put":    txOp("setName", "Set the value a name resolves to", "SetNameReq"),
+				"delete": txOp("deleteName", "Delete a name", "DeleteNameReq"),
+			},
+			path("/names/{name}"): schema{
+				"get": queryOp("resolveName", "Resolve a name to its value", schema{
+					"type":       "object",
+					"properties": schema{"value": schema{"type": "string"}},
+				}, nameParam),
+			},
+			path("/names/{name}/whois"): schema{
+				"get": queryOp("whoIs", "Get the owner, price, value and records of a name", ref("WhoIs"), nameParam),
+			},
+			path("/names/{name}/send"): schema{
+				"post": txOp("sendToName", "Send coins to whoever a name resolves to when the tx executes", "SendToNameReq", nameParam),
+			},
+			path("/watch"): schema{
+				"get": schema{
+					"operationId": "watchNames",
+					"summary":     "Stream name changes as Server-Sent Events, each data line a NameEvent",
+					"parameters": []schema{
+						{"name": "name", "in": "query", "schema": schema{"type": "string"}},
+						{"name": "owner", "in": "query", "description": "Current or previous owner address", "schema": schema{"type": "string"}},
+					},
+					"responses": schema{
+						"200": schema{
+							"description": "Event stream",
+							"content":     schema{"text/event-stream": schema{"schema": ref("NameEvent")}},
+						},
+						"400": response("BadRequest"),
+						"500": response("InternalError"),
+					},
+				},
+			},
+			path("/graphql"): schema{
+				"post": schema{
+					"operationId": "graphql",
+					"summary":     "Run a GraphQL query over names, accounts, records and sales",
+					"requestBody": schema{
+						"required": true,
+						"content": jsonContent(schema{
+							"type":     "object",
+							"required": []string{"query"},
+							"properties": schema{
+								"query":         schema{"type": "string"},
+								"operationName": schema{"type": "string"},
+								"variables":     schema{"type": "object"},
+							},
+						}),
+					},
+					"responses": schema{
+						"200": schema{
+							"description": "GraphQL response; query errors are reported in its errors field",
+							"content": jsonContent(schema{
+								"type": "object",
+								"properties": schema{
+									"data":   schema{"type": "object"},
+									"errors": arrayOf(schema{"type": "object"}),
+								},
+							}),
+						},
+						"400": schema{"description": "The body isn't a GraphQL request"},
+						"500": response("InternalError"),
+					},
+				},
+			},
+			path("/openapi.json"): schema{
+				"get": schema{
+					"operationId": "openAPI",
+					"summary":     "This document",
+					"responses": schema{
+						"200": schema{"description": "OpenAPI 3 document", "content": jsonContent(schema{"type": "object"})},
+					},
+				},
+			},
+		},
+		"components": openAPIComponents,
+	}
+}
+
+func openAPIHandler(storeName string) http.HandlerFunc {
+	spec, err := json.Marshal(openAPISpec(storeName))
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	}
+}
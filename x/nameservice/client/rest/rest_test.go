@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+const testStoreName = "nameservice"
+
+// The OpenAPI Document Must Describe Exactly The Routes RegisterRoutes Mounts
+func TestOpenAPISpecMatchesRoutes(t *testing.T) {
+	r := mux.NewRouter()
+	RegisterRoutes(context.CLIContext{}, r, testStoreName)
+
+	registered := make(map[string]bool)
+	prefix := "/" + testStoreName + "/"
+
+	err := r.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		tmpl, err := route.GetPathTemplate()
+		if err != nil || !strings.HasPrefix(tmpl, prefix) {
+			return nil
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			return err
+		}
+		for _, method := range methods {
+			registered[strings.ToLower(method)+" "+tmpl] = true
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(registered) == 0 {
+		t.Fatal("RegisterRoutes mounted no routes")
+	}
+
+	documented := make(map[string]bool)
+	for path, ops := range openAPISpec(testStoreName)["paths"].(schema) {
+		for method := range ops.(schema) {
+			documented[method+" "+path] = true
+		}
+	}
+
+	var mismatches []string
+	for route := range registered {
+		if !documented[route] {
+			mismatches = append(mismatches, "undocumented route: "+route)
+		}
+	}
+	for route := range documented {
+		if !registered[route] {
+			mismatches = append(mismatches, "documented route not registered: "+route)
+		}
+	}
+
+	if len(mismatches) > 0 {
+		sort.Strings(mismatches)
+		t.Errorf("OpenAPI document out of date:\n%s", strings.Join(mismatches, "\n"))
+	}
+}
@@ -3,10 +3,11 @@ package rest
 import (
 	"net/http"
 
+	"github.com/gorilla/mux"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
-	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
 )
 
@@ -32,6 +33,12 @@ type deleteNameReq struct {
 	Owner   string       `json:"owner"`
 }
 
+type sendToNameReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  string       `json:"amount"`
+	Sender  string       `json:"sender"`
+}
+
 // Defining Handlers For Transaction Commands (From /client/cli/tx.go)
 
 func buyNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -75,7 +82,7 @@ func buyNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// Generate Response
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeTxResponse(w, r, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
 
@@ -108,7 +115,7 @@ func setNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// Generate Response
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeTxResponse(w, r, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
 
@@ -141,6 +148,44 @@ func deleteNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// Generate Response
-		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+		writeTxResponse(w, r, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func sendToNameHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req sendToNameReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Retrieve Address
+		addr, err := sdk.AccAddressFromBech32(req.Sender)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		coins, err := sdk.ParseCoins(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Create Message, The Recipient Is Resolved When It Executes
+		msg := types.NewMsgSendToName(mux.Vars(r)[restName], coins, addr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Generate Response
+		writeTxResponse(w, r, cliCtx, baseReq, []sdk.Msg{msg})
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	stdcontext "context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/client/watch"
+)
+
+// Comment Sent On Idle Streams So Proxies Don't Time Them Out
+const keepAliveInterval = 15 * time.Second
+
+// watchHandler streams name changes as Server-Sent Events, optionally limited
+// to one name and/or owner: /nameservice/watch?name=alice&owner=cosmos1...
+// Every watcher shares one node subscription, opened on the first request.
+func watchHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	var once sync.Once
+	var hub *watch.Hub
+	var hubErr error
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		filter := watch.Filter{
+			Name:  r.URL.Query().Get("name"),
+			Owner: r.URL.Query().Get("owner"),
+		}
+
+		if filter.Owner != "" {
+			if _, err := sdk.AccAddressFromBech32(filter.Owner); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, "streaming unsupported")
+			return
+		}
+
+		// The Hub Gets Its Own Connection, As Subscriptions Are Per Connection & Query
+		once.Do(func() {
+			var node *rpcclient.HTTP
+			if node, hubErr = rpcclient.NewHTTP(cliCtx.NodeURI, "/websocket"); hubErr != nil {
+				return
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "nameservice-watch")
+			hub = watch.NewHub(node, logger)
+			go hub.Run(stdcontext.Background())
+		})
+		if hubErr != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, hubErr.Error())
+			return
+		}
+
+		events, cancel := hub.Watch(filter)
+		defer cancel()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, ": watching nameservice events\n\n")
+		flusher.Flush()
+
+		keepAlive := time.NewTicker(keepAliveInterval)
+		defer keepAlive.Stop()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+
+			case <-keepAlive.C:
+				fmt.Fprint(w, ": keep-alive\n\n")
+
+			case event, ok := <-events:
+				if !ok {
+					// Dropped For Falling Behind, The Client Should Reconnect
+					return
+				}
+
+				bz, err := json.Marshal(event)
+				if err != nil {
+					return
+				}
+				fmt.Fprintf(w, "event: %s\nid: %s\ndata: %s\n\n", event.Type, event.TxHash, bz)
+			}
+
+			flusher.Flush()
+		}
+	}
+}
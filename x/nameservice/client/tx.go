@@ -0,0 +1,41 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Tx builders return the module's messages after their stateless checks, ready
+// to be signed and broadcast, e.g. with an auth.TxBuilder.
+
+// BuyName builds a MsgBuyName bidding bid for name
+func BuyName(name string, bid sdk.Coins, buyer sdk.AccAddress) (types.MsgBuyName, error) {
+	msg := types.NewMsgBuyName(name, bid, buyer)
+	return msg, msg.ValidateBasic()
+}
+
+// SetName builds a MsgSetName setting the value of name
+func SetName(name string, value string, owner sdk.AccAddress) (types.MsgSetName, error) {
+	msg := types.NewMsgSetName(name, value, owner)
+	return msg, msg.ValidateBasic()
+}
+
+// DeleteName builds a MsgDeleteName deleting name
+func DeleteName(name string, owner sdk.AccAddress) (types.MsgDeleteName, error) {
+	msg := types.NewMsgDeleteName(name, owner)
+	return msg, msg.ValidateBasic()
+}
+
+// SetRecords builds a MsgSetRecords replacing the typed records of name
+func SetRecords(name string, records []types.Record, owner sdk.AccAddress) (types.MsgSetRecords, error) {
+	msg := types.NewMsgSetRecords(name, records, owner)
+	return msg, msg.ValidateBasic()
+}
+
+// SendToName builds a MsgSendToName paying amount to the account name
+// resolves to when the tx executes
+func SendToName(name string, amount sdk.Coins, sender sdk.AccAddress) (types.MsgSendToName, error) {
+	msg := types.NewMsgSendToName(name, amount, sender)
+	return msg, msg.ValidateBasic()
+}
@@ -0,0 +1,174 @@
+// Package watch turns the nameservice events committed on a node into a
+// stream of decoded name changes, for the REST watch endpoint and the
+// `query nameservice watch` command.
+package watch
+
+import (
+	"fmt"
+	"strings"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+const (
+	// Matches Every Tx Carrying A nameservice Message
+	eventQuery = "tm.event = 'Tx' AND message.module = 'nameservice'"
+
+	// Matches Every Block, Whose End-Block Events Carry What The EndBlocker Settled
+	blockQuery = "tm.event = 'NewBlock'"
+)
+
+// Event is a single change to a name, decoded from a module event of a
+// committed tx or of a block's EndBlocker, which carries no TxHash
+type Event struct {
+	Type          string `json:"type" yaml:"type"`
+	Name          string `json:"name" yaml:"name"`
+	Owner         string `json:"owner,omitempty" yaml:"owner,omitempty"`
+	PreviousOwner string `json:"previous_owner,omitempty" yaml:"previous_owner,omitempty"`
+	Value         string `json:"value,omitempty" yaml:"value,omitempty"`
+	Price         string `json:"price,omitempty" yaml:"price,omitempty"`
+	Records       string `json:"records,omitempty" yaml:"records,omitempty"`
+	Height        int64  `json:"height" yaml:"height"`
+	TxHash        string `json:"txhash" yaml:"txhash"`
+}
+
+// Event print function
+func (e Event) String() string {
+	return fmt.Sprintf("%d\t%s\t%s\t%s", e.Height, e.Type, e.Name, e.Owner)
+}
+
+// Filter selects the events a watcher receives. Empty fields match anything.
+type Filter struct {
+	Name  string `json:"name"`
+	Owner string `json:"owner"`
+}
+
+// Matches reports whether e passes the filter. An owner matches both the
+// name's new and previous owner, so a seller sees the sale.
+func (f Filter) Matches(e Event) bool {
+	if f.Name != "" && f.Name != e.Name {
+		return false
+	}
+
+	if f.Owner != "" && f.Owner != e.Owner && f.Owner != e.PreviousOwner {
+		return false
+	}
+
+	return true
+}
+
+// Module Event Types Reported As Name Changes
+var nameEventTypes = map[string]bool{
+	types.EventTypeRegisterName:    true,
+	types.EventTypeBuyName:         true,
+	types.EventTypeSetName:         true,
+	types.EventTypeDeleteName:      true,
+	types.EventTypeSetRecords:      true,
+	types.EventTypeSetOwners:       true,
+	types.EventTypeExecuteProposal: true,
+	types.EventTypeRecoverName:     true,
+	types.EventTypeSelfAssess:      true,
+	types.EventTypeDepositTax:      true,
+	types.EventTypeWithdrawTax:     true,
+	types.EventTypePayTax:          true,
+	types.EventTypeForecloseName:   true,
+	types.EventTypeEndAuction:      true,
+}
+
+// Decode returns the name changes carried by a tx or new block event, in the
+// order the module emitted them
+func Decode(result ctypes.ResultEvent) []Event {
+	switch data := result.Data.(type) {
+	case tmtypes.EventDataTx:
+		hash := strings.ToUpper(fmt.Sprintf("%x", tmtypes.Tx(data.Tx).Hash()))
+		return decodeEvents(data.Height, hash, data.Result.Events)
+	case tmtypes.EventDataNewBlock:
+		return decodeEvents(data.Block.Height, "", data.ResultEndBlock.Events)
+	default:
+		return nil
+	}
+}
+
+// DecodeTxResult returns the name changes carried by a tx found by a node's
+// tx search
+func DecodeTxResult(result *ctypes.ResultTx) []Event {
+	return decodeEvents(result.Height, result.Hash.String(), result.TxResult.Events)
+}
+
+func decodeEvents(height int64, hash string, abciEvents []abci.Event) []Event {
+	var events []Event
+	for _, event := range abciEvents {
+		if !nameEventTypes[event.Type] {
+			continue
+		}
+
+		e := decodeEvent(event)
+		e.Height = height
+		e.TxHash = hash
+
+		events = append(events, e)
+	}
+
+	return events
+}
+
+// DecodeTxResponse returns the name changes carried by the logs of a
+// broadcast tx. Only txs broadcast in block mode have been executed, and so
+// carry any.
+func DecodeTxResponse(res sdk.TxResponse) []Event {
+	var events []Event
+	for _, log := range res.Logs {
+		for _, event := range log.Events {
+			if !nameEventTypes[event.Type] {
+				continue
+			}
+
+			// Logs Merge Events Of A Type, Each Original Starting With Its Name
+			var e *Event
+			for _, attr := range event.Attributes {
+				if attr.Key == types.AttributeKeyName {
+					events = append(events, Event{Type: event.Type, Height: res.Height, TxHash: res.TxHash})
+					e = &events[len(events)-1]
+				}
+				if e != nil {
+					e.setAttribute(attr.Key, attr.Value)
+				}
+			}
+		}
+	}
+
+	return events
+}
+
+func decodeEvent(event abci.Event) Event {
+	e := Event{Type: event.Type}
+
+	for _, attr := range event.Attributes {
+		e.setAttribute(string(attr.Key), string(attr.Value))
+	}
+
+	return e
+}
+
+func (e *Event) setAttribute(key, value string) {
+	switch key {
+	case types.AttributeKeyName:
+		e.Name = value
+	case types.AttributeKeyOwner:
+		e.Owner = value
+	case types.AttributeKeyPreviousOwner:
+		e.PreviousOwner = value
+	case types.AttributeKeyValue:
+		e.Value = value
+	case types.AttributeKeyPrice:
+		e.Price = value
+	case types.AttributeKeyRecords:
+		e.Records = value
+	}
+}
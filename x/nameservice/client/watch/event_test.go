@@ -0,0 +1,64 @@
+package watch
+
+import (
+	"testing"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/kv"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+func event(eventType string, attrs ...string) abci.Event {
+	e := abci.Event{Type: eventType}
+	for i := 0; i < len(attrs); i += 2 {
+		e.Attributes = append(e.Attributes, kv.Pair{Key: []byte(attrs[i]), Value: []byte(attrs[i+1])})
+	}
+	return e
+}
+
+func TestDecodeTx(t *testing.T) {
+	events := Decode(ctypes.ResultEvent{Data: tmtypes.EventDataTx{TxResult: tmtypes.TxResult{
+		Height: 7,
+		Tx:     []byte("tx"),
+		Result: abci.ResponseDeliverTx{Events: []abci.Event{
+			event("message", "module", types.ModuleName),
+			event(types.EventTypeSetOwners, types.AttributeKeyName, "alice", types.AttributeKeyPreviousOwner, "owner"),
+			event(types.EventTypeRecoverName, types.AttributeKeyName, "bob", types.AttributeKeyOwner, "new"),
+		}},
+	}}})
+
+	if len(events) != 2 {
+		t.Fatalf("got %v, want the set_owners and recover_name events", events)
+	}
+	if e := events[0]; e.Type != types.EventTypeSetOwners || e.Name != "alice" || e.PreviousOwner != "owner" || e.Height != 7 || e.TxHash == "" {
+		t.Errorf("got %+v", e)
+	}
+	if e := events[1]; e.Type != types.EventTypeRecoverName || e.Name != "bob" || e.Owner != "new" {
+		t.Errorf("got %+v", e)
+	}
+}
+
+// Names Settled By The EndBlocker Come From New Block Events, Without A Tx Hash
+func TestDecodeNewBlock(t *testing.T) {
+	events := Decode(ctypes.ResultEvent{Data: tmtypes.EventDataNewBlock{
+		Block: &tmtypes.Block{Header: tmtypes.Header{Height: 9}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{
+			event(types.EventTypeForecloseName, types.AttributeKeyName, "alice", types.AttributeKeyOwner, "owner"),
+			event(types.EventTypeEndCooldown, types.AttributeKeyName, "bob"),
+			event(types.EventTypeEndAuction, types.AttributeKeyName, "carol", types.AttributeKeyOwner, "bidder", types.AttributeKeyPrice, "10nametoken"),
+		}},
+	}})
+
+	if len(events) != 2 {
+		t.Fatalf("got %v, want the foreclose_name and end_auction events", events)
+	}
+	if e := events[0]; e.Type != types.EventTypeForecloseName || e.Name != "alice" || e.Owner != "owner" || e.Height != 9 || e.TxHash != "" {
+		t.Errorf("got %+v", e)
+	}
+	if e := events[1]; e.Type != types.EventTypeEndAuction || e.Name != "carol" || e.Owner != "bidder" || e.Price != "10nametoken" {
+		t.Errorf("got %+v", e)
+	}
+}
@@ -0,0 +1,164 @@
+package watch
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	// Subscriber Name Used On The Tendermint Event Bus
+	subscriber = "nameservice-watch"
+
+	// Events Buffered Per Watcher Before It Is Considered Too Slow And Dropped
+	watcherBuffer = 64
+)
+
+// Stream subscribes to nameservice events on node and calls fn with every
+// event passing filter, until ctx is cancelled, fn fails or the subscription
+// ends. The node must be started. A block's end-block events are delivered
+// alongside its txs' events, not necessarily after them.
+func Stream(ctx context.Context, node rpcclient.Client, filter Filter, fn func(Event) error) error {
+	txs, err := node.Subscribe(ctx, subscriber, eventQuery)
+	if err != nil {
+		return err
+	}
+	defer node.Unsubscribe(context.Background(), subscriber, eventQuery) // nolint: errcheck
+
+	// Names Foreclosed Or Won At Auction Change Hands In The EndBlocker, Outside Any Tx
+	blocks, err := node.Subscribe(ctx, subscriber, blockQuery)
+	if err != nil {
+		return err
+	}
+	defer node.Unsubscribe(context.Background(), subscriber, blockQuery) // nolint: errcheck
+
+	for {
+		var result ctypes.ResultEvent
+		var ok bool
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case result, ok = <-txs:
+		case result, ok = <-blocks:
+		}
+
+		if !ok {
+			return nil
+		}
+
+		for _, event := range Decode(result) {
+			if !filter.Matches(event) {
+				continue
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Hub shares a single node subscription between any number of watchers. The
+// node only allows one subscription per connection and query, so a hub should
+// own its client rather than share one with other subscribers.
+type Hub struct {
+	node   rpcclient.Client
+	logger log.Logger
+
+	mtx      sync.Mutex
+	watchers map[*watcher]struct{}
+}
+
+type watcher struct {
+	filter Filter
+	events chan Event
+}
+
+// NewHub creates a Hub for node; Run must be called for it to deliver events
+func NewHub(node rpcclient.Client, logger log.Logger) *Hub {
+	return &Hub{
+		node:     node,
+		logger:   logger,
+		watchers: make(map[*watcher]struct{}),
+	}
+}
+
+// Watch registers a watcher for the events passing filter. The channel is
+// closed when cancel is called, or early if the watcher falls too far behind.
+func (h *Hub) Watch(filter Filter) (events <-chan Event, cancel func()) {
+	w := &watcher{filter: filter, events: make(chan Event, watcherBuffer)}
+
+	h.mtx.Lock()
+	h.watchers[w] = struct{}{}
+	h.mtx.Unlock()
+
+	return w.events, func() { h.remove(w) }
+}
+
+func (h *Hub) remove(w *watcher) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.events)
+	}
+}
+
+// Run keeps the hub subscribed until ctx is cancelled, resubscribing with
+// backoff whenever the subscription fails
+func (h *Hub) Run(ctx context.Context) {
+	if !h.node.IsRunning() {
+		if err := h.node.Start(); err != nil {
+			h.logger.Error("Name watching disabled", "err", err)
+			return
+		}
+		defer h.node.Stop() // nolint: errcheck
+	}
+
+	backoff := time.Second
+	for {
+		err := Stream(ctx, h.node, Filter{}, func(event Event) error {
+			h.broadcast(event)
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+
+		h.logger.Error("Nameservice event subscription dropped", "err", err, "retry", backoff)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		if backoff < time.Minute {
+			backoff *= 2
+		}
+	}
+}
+
+func (h *Hub) broadcast(event Event) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	for w := range h.watchers {
+		if !w.filter.Matches(event) {
+			continue
+		}
+
+		select {
+		case w.events <- event:
+		default:
+			// A Watcher That Can't Keep Up Is Dropped Rather Than Stall The Rest
+			delete(h.watchers, w)
+			close(w.events)
+		}
+	}
+}
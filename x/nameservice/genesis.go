@@ -9,57 +9,69 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-type GenesisState struct {
-	whoIsRecords []types.WhoIs `json:"whois_records"`
-}
+// InitGenesis initialize default parameters
+// and the keeper's address to pubkey map
 
-// Constructor 
+func InitGenesis(ctx sdk.Context, k Keeper, /* TODO: Define what keepers the module needs */ genState GenesisState) []abci.ValidatorUpdate {
+	
+	// Fetch & Iterate Through Names' whoIs
 
-func NewGenesisState(whoIsRecords []types.WhoIs) GenesisState {
-	return GenesisState{whoIsRecords: whoIsRecords}
-}
+	k.SetParams(ctx, genState.Params)
 
-// Default 
+	for _, record := range genState.WhoIsRecords {
+		// Assign whoIs Structures
+		k.SetWhoIs(ctx, record.Name, record.WhoIs)
 
-func DefaultGenesisState() GenesisState {
-	return GenesisState{
-		whoIsRecords: []types.WhoIs{},
+		// Harberger Names Are Queued Again For Their Next Tax
+		if record.WhoIs.SelfAssessed {
+			k.SetTaxPaidThrough(ctx, record.Name, record.WhoIs.TaxPaidThrough)
+		}
 	}
-}
 
-func ValidateGenesis(genState GenesisState) error {
+	for _, approval := range genState.Operators {
+		k.SetOperator(ctx, approval)
+	}
 
-	// Fetch & Iterate Through Names' whoIs
-	for _, whoIs := range genState.whoIsRecords {
+	for _, allowance := range genState.FeeAllowances {
+		k.SetFeeAllowance(ctx, allowance)
+	}
 
-		if whoIs.Owner == nil {
-			return fmt.Errorf("Invalid whoIsRecord: %s (Value) - Missing Owner", whoIs.Value)
-		}
+	for _, proposal := range genState.Proposals {
+		k.SetProposal(ctx, proposal)
+	}
 
-		if whoIs.Value == "" {
-			return fmt.Errorf("Invalid whoIsRecord: %s (Owner) - Missing Value", whoIs.Owner)
-		}
+	if genState.NextProposalID != 0 {
+		k.SetNextProposalID(ctx, genState.NextProposalID)
+	}
 
-		if whoIs.Price == nil {
-			return fmt.Errorf("Invalid whoIsRecord: %s (Value) - Missing Price", whoIs.Value)
-		}
+	for _, set := range genState.GuardianSets {
+		k.SetGuardianSet(ctx, set)
 	}
 
-	return nil
-}
+	for _, recovery := range genState.Recoveries {
+		k.SetRecovery(ctx, recovery)
+	}
 
+	// Accepted Leases Are Queued Again As They're Set
+	for _, lease := range genState.Leases {
+		k.SetLease(ctx, lease)
+	}
 
-// InitGenesis initialize default parameters
-// and the keeper's address to pubkey map
+	for _, auction := range genState.Auctions {
+		k.SetAuction(ctx, auction)
+	}
 
-func InitGenesis(ctx sdk.Context, k Keeper, /* TODO: Define what keepers the module needs */ genState GenesisState) []abci.ValidatorUpdate {
-	
-	// Fetch & Iterate Through Names' whoIs
+	for _, cooldown := range genState.Cooldowns {
+		k.SetCooldown(ctx, cooldown)
+	}
 
-	for _, whoIs := range genState.whoIsRecords {
-		// Assign whoIs Structures
-		k.SetWhoIs(ctx, whoIs.Value, whoIs)
+	// The Module Account's Balance Must Cover Tax Deposits And Escrowed Prices Too,
+	// Along With Lease Escrows, Fee Allowances And Winning Bids, Or Paying Them Out Would Fail
+	held := genState.HeldCoins()
+	if balance := k.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins(); !balance.IsAllGTE(held) {
+		panic(fmt.Sprintf("%s module account holds %s, less than the %s held for its names", types.ModuleName, balance, held))
 	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -69,10 +81,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, /* TODO: Define what keepers the mod
 
 func ExportGenesis(ctx sdk.Context, k Keeper) (GenesisState) {
 	
-	var names []types.WhoIs
+	names := []types.GenesisWhoIs{}
 
 	// Retrieve All The Names
 	iterator := k.GetNamesIterator(ctx)
+	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
 
@@ -83,8 +96,30 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (GenesisState) {
 		whois := k.GetWhoIs(ctx, key)
 
 		// Append To Names List
-		names = append(names, whois)
+		names = append(names, types.GenesisWhoIs{Name: key, WhoIs: whois})
 	}
 
-	return GenesisState{whoIsRecords: names}
+	// Retrieve All The Operator Approvals
+	operators := k.GetAllOperators(ctx)
+
+	// Retrieve All The Fee Allowances
+	feeAllowances := k.GetAllFeeAllowances(ctx)
+
+	// Retrieve All The Proposals, Expired Ones Included Until They're Pruned
+	proposals := k.GetAllProposals(ctx)
+
+	// Retrieve All The Guardian Sets & Recoveries
+	guardianSets := k.GetAllGuardianSets(ctx)
+	recoveries := k.GetAllRecoveries(ctx)
+
+	// Retrieve All The Leases & Lease Offers
+	leases := k.GetAllLeases(ctx)
+
+	// Retrieve All The Auctions Still Running
+	auctions := k.GetAllAuctions(ctx)
+
+	// Retrieve All The Deleted Names Still Cooling Down
+	cooldowns := k.GetAllCooldowns(ctx)
+
+	return NewGenesisState(names, operators, feeAllowances, proposals, k.GetNextProposalID(ctx), guardianSets, recoveries, leases, auctions, cooldowns, k.GetParams(ctx))
 }
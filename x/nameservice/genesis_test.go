@@ -0,0 +1,52 @@
+package nameservice
+
+import (
+	"testing"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+func TestInitGenesisChecksModuleBalance(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 0)
+
+	genState := DefaultGenesisState()
+	genState.WhoIsRecords = []types.GenesisWhoIs{{
+		Name: "alice",
+		WhoIs: types.WhoIs{
+			Owner:        owner,
+			Price:        coins(100),
+			SelfAssessed: true,
+			Deposit:      coins(10),
+			Escrow:       coins(100),
+		},
+	}}
+
+	if got := genState.HeldCoins(); !got.IsEqual(coins(110)) {
+		t.Fatalf("genesis holds %s, want the deposit and escrow", got)
+	}
+
+	initGenesis := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		InitGenesis(e.Ctx, e.Keeper, genState)
+		return false
+	}
+
+	// The EndBlocker Pays Deposits And Escrows Out Of The Module Account, So It Must Hold Them
+	if !initGenesis() {
+		t.Fatal("genesis was accepted with an empty module account")
+	}
+
+	moduleAddr := e.SupplyKeeper.GetModuleAddress(types.ModuleName)
+	if err := e.BankKeeper.SetCoins(e.Ctx, moduleAddr, coins(110)); err != nil {
+		t.Fatal(err)
+	}
+	if initGenesis() {
+		t.Fatal("genesis was refused with a funded module account")
+	}
+
+	exported := ExportGenesis(e.Ctx, e.Keeper)
+	if got := exported.HeldCoins(); !got.IsEqual(coins(110)) {
+		t.Fatalf("exported genesis holds %s, want 110nametoken", got)
+	}
+}
@@ -2,11 +2,14 @@ package nameservice
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 // NewHandler creates an sdk.Handler for all the nameservice type messages
@@ -20,6 +23,48 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgBuyName(ctx, k, msg)
 		case MsgDeleteName:
 			return handleMsgDeleteName(ctx, k, msg)
+		case MsgSetRecords:
+			return handleMsgSetRecords(ctx, k, msg)
+		case MsgSendToName:
+			return handleMsgSendToName(ctx, k, msg)
+		case MsgBatch:
+			return handleMsgBatch(ctx, k, msg)
+		case MsgApproveOperator:
+			return handleMsgApproveOperator(ctx, k, msg)
+		case MsgRevokeOperator:
+			return handleMsgRevokeOperator(ctx, k, msg)
+		case MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, k, msg)
+		case MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, k, msg)
+		case MsgSetOwners:
+			return handleMsgSetOwners(ctx, k, msg)
+		case MsgPropose:
+			return handleMsgPropose(ctx, k, msg)
+		case MsgApproveProposal:
+			return handleMsgApproveProposal(ctx, k, msg)
+		case MsgSetGuardians:
+			return handleMsgSetGuardians(ctx, k, msg)
+		case MsgStartRecovery:
+			return handleMsgStartRecovery(ctx, k, msg)
+		case MsgApproveRecovery:
+			return handleMsgApproveRecovery(ctx, k, msg)
+		case MsgVetoRecovery:
+			return handleMsgVetoRecovery(ctx, k, msg)
+		case MsgFinalizeRecovery:
+			return handleMsgFinalizeRecovery(ctx, k, msg)
+		case MsgOfferLease:
+			return handleMsgOfferLease(ctx, k, msg)
+		case MsgAcceptLease:
+			return handleMsgAcceptLease(ctx, k, msg)
+		case MsgEndLease:
+			return handleMsgEndLease(ctx, k, msg)
+		case MsgSelfAssess:
+			return handleMsgSelfAssess(ctx, k, msg)
+		case MsgDepositTax:
+			return handleMsgDepositTax(ctx, k, msg)
+		case MsgWithdrawTax:
+			return handleMsgWithdrawTax(ctx, k, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", ModuleName,  msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -30,30 +75,106 @@ func NewHandler(k Keeper) sdk.Handler {
 // Handler Functions
 
 func handleMsgSetName(ctx sdk.Context, keeper Keeper, msg MsgSetName) (*sdk.Result, error){
-	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	if !keeper.IsAuthorized(ctx, msg.Name, msg.Owner, types.ScopeManage) {
+		return nil, unauthorized(ctx, keeper, msg.Name, msg.Owner, "Not The Owner Or An Approved Operator")
 	}
 
+	owner := keeper.GetOwner(ctx, msg.Name)
+
 	keeper.SetName(ctx, msg.Name, msg.Value) 
-	return &sdk.Result{}, nil
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetName,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+				sdk.NewAttribute(types.AttributeKeyValue, msg.Value),
+			}, ownerAttributes(owner, msg.Owner)...)...,
+		),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 func handleMsgBuyName(ctx sdk.Context, keeper Keeper, msg MsgBuyName) (*sdk.Result, error) {
+	if err := leased(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	// A Harberger Name Pays Its Tax Up To The Sale, Or Is Foreclosed And Registered Afresh
+	if _, err := settleTax(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	selfAssessed := keeper.GetWhoIs(ctx, msg.Name).SelfAssessed
+	auction, auctioned := keeper.GetAuction(ctx, msg.Name)
+
+	// With A Bidding Window, Buying An Auctioned Name Is Bidding On It
+	if auctioned && (auction.Bidding() || keeper.GetParams(ctx).AuctionBidBlocks > 0) {
+		return bidAuction(ctx, keeper, auction, msg)
+	}
+
+	// A Deleted Name Cools Down, Only Its Last Owners Able To Register It Again
+	if cooldown, found := keeper.GetCooldown(ctx, msg.Name); found && !containsAddress(cooldown.Owners, msg.Buyer) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Was Deleted, Only Its Last Owners Can Register It Until Block %d", msg.Name, cooldown.Until)
+	}
+
+	// Names With Several Owners Are Only Sold To A Buyer They Approved, At The Price They Approved
+	if keeper.GetWhoIs(ctx, msg.Name).IsMultiOwner() {
+		sale, found := keeper.GetApprovedSale(ctx, msg.Name, msg.Buyer)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Has Several Owners, Its Sale Must Be Proposed To Them", msg.Name)
+		}
+
+		if sale.Action.Price.IsAllGT(msg.Bid) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Bid Didn't Meet The Approved Price")
+		}
+
+	// Released Names Sell At Their Auction's Price, Which Falls Every Block Until It Ends
+	} else if auctioned {
+		if auction.Price(ctx.BlockHeight()).IsAllGT(msg.Bid) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Bid Didn't Meet The Auction's Current Price %s", auction.Price(ctx.BlockHeight()))
+		}
 
 	// Check If Current Price > Bid
-	if keeper.GetPrice(ctx, msg.Name).IsAllGT(msg.Bid) {
+	} else if keeper.GetPrice(ctx, msg.Name).IsAllGT(msg.Bid) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "Bid Didn't Surpass Current Price")
 	}
 
+	previousOwner := keeper.GetOwner(ctx, msg.Name)
+	previousOwners := keeper.GetWhoIs(ctx, msg.Name).AllOwners()
+
+	// A Harberger Name Sells At The Price Its Owner Declared, Whatever The Bid
+	// As Does An Auctioned Name At Its Current Price
+	payment := msg.Bid
+	if selfAssessed {
+		payment = keeper.GetPrice(ctx, msg.Name)
+	} else if auctioned {
+		payment = auction.Price(ctx.BlockHeight())
+	}
+
+	// Several Owners Share The Proceeds
 	if keeper.HasOwner(ctx, msg.Name) {
-		err := keeper.CoinKeeper.SendCoins(ctx, msg.Buyer, keeper.GetOwner(ctx, msg.Name), msg.Bid)
+		err := payOwners(ctx, keeper, msg.Buyer, previousOwners, payment)
 		
+		// Error Occurred
+		if err != nil {
+			return nil, err
+		}
+	} else if auctioned {
+
+		// Auction Proceeds Go To The Community Pool, Wherever The Auction Closes
+		err := keeper.DistrKeeper.FundCommunityPool(ctx, payment, msg.Buyer)
+
 		// Error Occurred
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		_, err := keeper.CoinKeeper.SubtractCoins(ctx, msg.Buyer, msg.Bid)
+
+		// Escrowed, So Owners Deleting The Name Can Get Some Of It Back
+		err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Buyer, types.ModuleName, payment)
 
 		// Error Occured
 		if err != nil {
@@ -61,9 +182,52 @@ func handleMsgBuyName(ctx sdk.Context, keeper Keeper, msg MsgBuyName) (*sdk.Resu
 		}
 	}
 
+	// The Previous Owner's Operators, Proposals, Guardians, Tax Deposit And Escrowed Price Don't Carry Over To The Buyer
+	if !previousOwner.Empty() {
+		if err := refundDeposit(ctx, keeper, msg.Name); err != nil {
+			return nil, err
+		}
+		if _, err := refundEscrow(ctx, keeper, msg.Name); err != nil {
+			return nil, err
+		}
+		dropOwnership(ctx, keeper, msg.Name)
+	}
+
+	keeper.DeleteAuction(ctx, msg.Name)
+	keeper.DeleteCooldown(ctx, msg.Name)
 	keeper.SetOwner(ctx, msg.Name, msg.Buyer)
 	keeper.SetPrice(ctx, msg.Name, msg.Bid)
-	return &sdk.Result{}, nil
+
+	if previousOwner.Empty() && !auctioned {
+		whois := keeper.GetWhoIs(ctx, msg.Name)
+		whois.Escrow = payment
+		keeper.SetWhoIs(ctx, msg.Name, whois)
+	}
+
+	// The Bid Is The Buyer's First Assessment, Taxed From Now On
+	if selfAssessed || (previousOwner.Empty() && keeper.GetParams(ctx).HarbergerDefault) {
+		startTax(ctx, keeper, msg.Name)
+	}
+
+	// Unowned Names Are Registered, Owned Names Are Sold
+	eventType := types.EventTypeRegisterName
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Buyer.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, msg.Bid.String()),
+		sdk.NewAttribute(types.AttributeKeyPaid, payment.String()),
+	}
+	if !previousOwner.Empty() {
+		eventType = types.EventTypeBuyName
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyPreviousOwner, previousOwner.String()))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(eventType, attributes...),
+		messageEvent(msg.Buyer),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
 func handleMsgDeleteName(ctx sdk.Context, keeper Keeper, msg MsgDeleteName) (*sdk.Result, error){
@@ -71,11 +235,1444 @@ func handleMsgDeleteName(ctx sdk.Context, keeper Keeper, msg MsgDeleteName) (*sd
 		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
 	}
 
+	if !keeper.ActsForOwners(ctx, msg.Name, msg.Owner) {
+		return nil, unauthorized(ctx, keeper, msg.Name, msg.Owner, "Incorrect Owner")
+	}
+
+	if err := leased(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	// A Harberger Name Pays Its Tax Up To Now, Or Is Foreclosed And Never Reaches Its Owners
+	foreclosed, err := settleTax(ctx, keeper, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+	}
+
+	if !foreclosed {
+		refund, err := retireName(ctx, keeper, msg.Name)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyRefund, refund.String()))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeDeleteName, attributes...),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetRecords(ctx sdk.Context, keeper Keeper, msg MsgSetRecords) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	if !keeper.IsAuthorized(ctx, msg.Name, msg.Owner, types.ScopeRecords) {
+		return nil, unauthorized(ctx, keeper, msg.Name, msg.Owner, "Not The Owner Or An Approved Operator")
+	}
+
+	// ADDR Records Redirect Coins Sent To The Name, So Records Operators & Lessees Can't Touch Them
+	if !equalAddrRecords(keeper.GetRecords(ctx, msg.Name), msg.Records) && !keeper.MayDirectPayments(ctx, msg.Name, msg.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Only The Owner Or A Manage Operator May Change ADDR Records")
+	}
+
+	owner := keeper.GetOwner(ctx, msg.Name)
+
+	keeper.SetRecords(ctx, msg.Name, msg.Records)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetRecords,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+				sdk.NewAttribute(types.AttributeKeyRecords, fmt.Sprintf("%d", len(msg.Records))),
+			}, ownerAttributes(owner, msg.Owner)...)...,
+		),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSendToName(ctx sdk.Context, keeper Keeper, msg MsgSendToName) (*sdk.Result, error) {
+	if !keeper.CoinKeeper.GetSendEnabled(ctx) {
+		return nil, bank.ErrSendDisabled
+	}
+
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	// Resolved At Execution Time, So A Transfer Or Record Change Ahead Of This Tx Is Honoured
+	recipient := keeper.GetPaymentAddress(ctx, msg.Name)
+	if recipient.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s has no owner", msg.Name)
+	}
+
+	if keeper.CoinKeeper.BlacklistedAddr(recipient) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", recipient)
+	}
+
+	err := keeper.CoinKeeper.SendCoins(ctx, msg.Sender, recipient, msg.Amount)
+
+	// Error Occurred
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSendToName,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		messageEvent(msg.Sender),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApproveOperator(ctx sdk.Context, keeper Keeper, msg MsgApproveOperator) (*sdk.Result, error) {
+	// An Approval On One Name Needs Its Owner, One On All Names Covers Whatever The Signer Owns
+	if msg.Name != "" {
+		if !keeper.IsNamePresent(ctx, msg.Name) {
+			return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+		}
+
+		if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+		}
+
+		if keeper.GetWhoIs(ctx, msg.Name).IsMultiOwner() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Has Several Owners, Operators Can't Act On It", msg.Name)
+		}
+	}
+
+	if !msg.Expiry.IsZero() && !msg.Expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Expiry %s has already passed", msg.Expiry)
+	}
+
+	keeper.SetOperator(ctx, types.OperatorApproval{
+		Owner: msg.Owner,
+		Name: msg.Name,
+		Operator: msg.Operator,
+		Scope: msg.Scope,
+		Expiry: msg.Expiry,
+	})
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+		sdk.NewAttribute(types.AttributeKeyScope, msg.Scope),
+	}
+	if !msg.Expiry.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyExpiry, msg.Expiry.UTC().Format(time.RFC3339)))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeApproveOperator, attributes...),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeOperator(ctx sdk.Context, keeper Keeper, msg MsgRevokeOperator) (*sdk.Result, error) {
+	if !keeper.HasOperator(ctx, msg.Owner, msg.Name, msg.Operator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s isn't an operator of %s", msg.Operator, msg.Owner)
+	}
+
+	keeper.DeleteOperator(ctx, msg.Owner, msg.Name, msg.Operator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeOperator,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator.String()),
+		),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Context, keeper Keeper, msg MsgGrantFeeAllowance) (*sdk.Result, error) {
+	if !msg.Expiry.IsZero() && !msg.Expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Expiry %s has already passed", msg.Expiry)
+	}
+
+	// The Allowance Is Escrowed, So It Can Always Pay What It Promises
+	err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Granter, types.ModuleName, msg.Amount)
+
+	// Error Occurred
+	if err != nil {
+		return nil, err
+	}
+
+	// A Grantee Without An Account Could Never Sign The Txs Its Allowance Pays For
+	if keeper.AccountKeeper.GetAccount(ctx, msg.Grantee) == nil {
+		keeper.AccountKeeper.SetAccount(ctx, keeper.AccountKeeper.NewAccountWithAddress(ctx, msg.Grantee))
+	}
+
+	allowance, _ := keeper.GetFeeAllowance(ctx, msg.Grantee, msg.Granter)
+	allowance.Granter = msg.Granter
+	allowance.Grantee = msg.Grantee
+	allowance.Remaining = allowance.Remaining.Add(msg.Amount...)
+	allowance.SpendLimit = msg.SpendLimit
+	allowance.Expiry = msg.Expiry
+
+	keeper.SetFeeAllowance(ctx, allowance)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyRemaining, allowance.Remaining.String()),
+	}
+	if !msg.SpendLimit.Empty() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeySpendLimit, msg.SpendLimit.String()))
+	}
+	if !msg.Expiry.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyExpiry, msg.Expiry.UTC().Format(time.RFC3339)))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeGrantFeeAllowance, attributes...),
+		messageEvent(msg.Granter),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, keeper Keeper, msg MsgRevokeFeeAllowance) (*sdk.Result, error) {
+	allowance, found := keeper.GetFeeAllowance(ctx, msg.Grantee, msg.Granter)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no fee allowance from %s", msg.Grantee, msg.Granter)
+	}
+
+	// Whatever Is Left, Expired Or Not, Goes Back To The Granter
+	if !allowance.Remaining.IsZero() {
+		err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.Granter, allowance.Remaining)
+
+		// Error Occurred
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keeper.DeleteFeeAllowance(ctx, msg.Grantee, msg.Granter)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, allowance.Remaining.String()),
+		),
+		messageEvent(msg.Granter),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetOwners(ctx sdk.Context, keeper Keeper, msg MsgSetOwners) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	if !keeper.ActsForOwners(ctx, msg.Name, msg.Owner) {
+		return nil, unauthorized(ctx, keeper, msg.Name, msg.Owner, "Incorrect Owner")
+	}
+
+	if err := leased(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	if keeper.GetWhoIs(ctx, msg.Name).SelfAssessed && len(msg.Owners) > 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Is Under Harberger Tax, It Can Only Have One Owner", msg.Name)
+	}
+
+	previousOwner := keeper.GetOwner(ctx, msg.Name)
+
+	setOwners(ctx, keeper, msg.Name, msg.Owners, msg.Threshold)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetOwners,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwners, joinAddresses(msg.Owners)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", msg.Threshold)),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, previousOwner.String()),
+		),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgPropose(ctx sdk.Context, keeper Keeper, msg MsgPropose) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	whois := keeper.GetWhoIs(ctx, msg.Name)
+	if !whois.IsMultiOwner() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s Has A Single Owner, Who Acts On It Directly", msg.Name)
+	}
+
+	if !whois.IsOwner(msg.Proposer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Not One Of The Owners")
+	}
+
+	expiry := msg.Expiry
+	if expiry.IsZero() {
+		expiry = ctx.BlockTime().Add(types.DefaultProposalPeriod)
+	} else if !expiry.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Expiry %s has already passed", msg.Expiry)
+	}
+
+	// Stale Proposals Are Cleared Out As New Ones Come In
+	keeper.PruneProposals(ctx, msg.Name)
+
+	id := keeper.GetNextProposalID(ctx)
+	keeper.SetNextProposalID(ctx, id+1)
+
+	proposal := types.Proposal{
+		ID: id,
+		Name: msg.Name,
+		Action: msg.Action,
+		Proposer: msg.Proposer,
+		Approvals: []sdk.AccAddress{msg.Proposer},
+		Expiry: expiry,
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePropose,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyAction, msg.Action.Type),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Proposer.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiry, expiry.UTC().Format(time.RFC3339)),
+		),
+		messageEvent(msg.Proposer),
+	})
+
+	if err := approveProposal(ctx, keeper, whois, proposal); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApproveProposal(ctx sdk.Context, keeper Keeper, msg MsgApproveProposal) (*sdk.Result, error) {
+	proposal, found := keeper.GetProposal(ctx, msg.Name, msg.ID)
+	if !found || proposal.Expired(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no open proposal %d", msg.Name, msg.ID)
+	}
+
+	whois := keeper.GetWhoIs(ctx, msg.Name)
+	if !whois.IsOwner(msg.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Not One Of The Owners")
+	}
+
+	if proposal.Passed {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Proposal %d has already passed", msg.ID)
+	}
+
+	if proposal.HasApproved(msg.Owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has already approved proposal %d", msg.Owner, msg.ID)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.Owner)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveProposal,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", msg.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d", len(proposal.Approvals))),
+		),
+		messageEvent(msg.Owner),
+	})
+
+	if err := approveProposal(ctx, keeper, whois, proposal); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetGuardians(ctx sdk.Context, keeper Keeper, msg MsgSetGuardians) (*sdk.Result, error) {
+	// A Set On One Name Needs Its Single Owner, One On All Names Covers Whatever The Signer Owns Alone
+	if msg.Name != "" {
+		if !keeper.IsNamePresent(ctx, msg.Name) {
+			return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+		}
+
+		whois := keeper.GetWhoIs(ctx, msg.Name)
+		if whois.IsMultiOwner() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s Has Several Owners, Who Guard It Themselves", msg.Name)
+		}
+
+		if !msg.Owner.Equals(whois.Owner) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+		}
+	}
+
+	if len(msg.Guardians) == 0 {
+		if _, found := keeper.GetGuardianSet(ctx, msg.Owner, msg.Name); !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no guardian set on %q", msg.Owner, msg.Name)
+		}
+
+		keeper.DeleteGuardianSet(ctx, msg.Owner, msg.Name)
+	} else {
+		keeper.SetGuardianSet(ctx, types.GuardianSet{
+			Owner: msg.Owner,
+			Name: msg.Name,
+			Guardians: msg.Guardians,
+			Threshold: msg.Threshold,
+			Delay: msg.Delay,
+		})
+	}
+
+	// Recoveries Already Started Keep The Guardians They Started With
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetGuardians,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardians, joinAddresses(msg.Guardians)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", msg.Threshold)),
+			sdk.NewAttribute(types.AttributeKeyDelay, msg.Delay.String()),
+		),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgStartRecovery(ctx sdk.Context, keeper Keeper, msg MsgStartRecovery) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	guardians, found := keeper.GetGuardians(ctx, msg.Name)
+	if !found || !guardians.IsGuardian(msg.Guardian) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Not A Guardian Of The Name")
+	}
+
+	owner := keeper.GetOwner(ctx, msg.Name)
+	if msg.NewOwner.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s already owns %s", msg.NewOwner, msg.Name)
+	}
+
+	// Recoveries That Didn't Gather Enough Approvals In Time Are Cleared Out As New Ones Start
+	keeper.PruneRecoveries(ctx, msg.Name)
+
+	if _, found := keeper.GetRecovery(ctx, msg.Name, msg.NewOwner); found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "A recovery of %s to %s is already under way", msg.Name, msg.NewOwner)
+	}
+
+	recovery := types.Recovery{
+		Name: msg.Name,
+		Owner: owner,
+		NewOwner: msg.NewOwner,
+		Guardians: guardians.Guardians,
+		Threshold: guardians.Threshold,
+		Delay: guardians.Delay,
+		Started: ctx.BlockTime(),
+	}
+
+	// Wallets Watch For This Event To Warn The Owner
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeStartRecovery,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardian, msg.Guardian.String()),
+			sdk.NewAttribute(types.AttributeKeyThreshold, fmt.Sprintf("%d", recovery.Threshold)),
+		),
+		messageEvent(msg.Guardian),
+	})
+
+	approveRecovery(ctx, keeper, recovery, msg.Guardian)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApproveRecovery(ctx sdk.Context, keeper Keeper, msg MsgApproveRecovery) (*sdk.Result, error) {
+	recovery, err := openRecovery(ctx, keeper, msg.Name, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsAddress(recovery.Guardians, msg.Guardian) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Not A Guardian Of The Recovery")
+	}
+
+	if recovery.Approved() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "The recovery of %s to %s is already approved", msg.Name, msg.NewOwner)
+	}
+
+	if recovery.HasApproved(msg.Guardian) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has already approved the recovery of %s", msg.Guardian, msg.Name)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveRecovery,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, recovery.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyGuardian, msg.Guardian.String()),
+		),
+		messageEvent(msg.Guardian),
+	})
+
+	approveRecovery(ctx, keeper, recovery, msg.Guardian)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgVetoRecovery(ctx sdk.Context, keeper Keeper, msg MsgVetoRecovery) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
 	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
 	}
 
-	keeper.DeleteWhoIs(ctx, msg.Name)
-	return &sdk.Result{}, nil
+	var vetoed []types.Recovery
+	if msg.NewOwner.Empty() {
+		vetoed = keeper.GetRecoveries(ctx, msg.Name)
+	} else if recovery, found := keeper.GetRecovery(ctx, msg.Name, msg.NewOwner); found {
+		vetoed = []types.Recovery{recovery}
+	}
+
+	if len(vetoed) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no recovery under way", msg.Name)
+	}
+
+	for _, recovery := range vetoed {
+		keeper.DeleteRecovery(ctx, msg.Name, recovery.NewOwner)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeVetoRecovery,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, recovery.NewOwner.String()),
+		))
+	}
+
+	ctx.EventManager().EmitEvent(messageEvent(msg.Owner))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgFinalizeRecovery(ctx sdk.Context, keeper Keeper, msg MsgFinalizeRecovery) (*sdk.Result, error) {
+	recovery, err := openRecovery(ctx, keeper, msg.Name, msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !recovery.Ready(ctx.BlockTime()) {
+		if !recovery.Approved() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "The recovery of %s has %d of the %d approvals it needs", msg.Name, len(recovery.Approvals), recovery.Threshold)
+		}
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "The recovery of %s can't complete before %s", msg.Name, recovery.ReadyAt.UTC().Format(time.RFC3339))
+	}
+
+	// An Approved Recovery Doesn't Lapse, So It Can Wait Out The Lease
+	if err := leased(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	setOwners(ctx, keeper, msg.Name, []sdk.AccAddress{msg.NewOwner}, 1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRecoverName,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousOwner, recovery.Owner.String()),
+		),
+		messageEvent(msg.Signer),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgOfferLease(ctx sdk.Context, keeper Keeper, msg MsgOfferLease) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	if keeper.GetWhoIs(ctx, msg.Name).IsMultiOwner() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Has Several Owners, It Can't Be Leased", msg.Name)
+	}
+
+	// Anyone May Buy A Harberger Name At Any Time, Which A Lease Would Get In The Way Of
+	if keeper.GetWhoIs(ctx, msg.Name).SelfAssessed {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Is Under Harberger Tax, It Can't Be Leased", msg.Name)
+	}
+
+	// The Owner Leases The Name, Its Lessee Sublets It, And So On Down The Chain
+	controller := keeper.GetController(ctx, msg.Name)
+	if !msg.Lessor.Equals(controller) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "Only %s, Who Controls %s, Can Lease It", controller, msg.Name)
+	}
+
+	if err := checkSublease(ctx, keeper, msg.Name, msg.Lessee, ctx.BlockHeight()+msg.Blocks); err != nil {
+		return nil, err
+	}
+
+	// A New Offer Replaces The Lessor's Earlier One
+	keeper.DeleteLease(ctx, msg.Name, msg.Lessor)
+	keeper.SetLease(ctx, types.Lease{
+		Name: msg.Name,
+		Lessor: msg.Lessor,
+		Lessee: msg.Lessee,
+		Blocks: msg.Blocks,
+		Fee: msg.Fee,
+		Period: msg.Period,
+	})
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOfferLease,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyLessor, msg.Lessor.String()),
+			sdk.NewAttribute(types.AttributeKeyLessee, msg.Lessee.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, msg.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyPeriod, fmt.Sprintf("%d", msg.Period)),
+		),
+		messageEvent(msg.Lessor),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAcceptLease(ctx sdk.Context, keeper Keeper, msg MsgAcceptLease) (*sdk.Result, error) {
+	lease, found := keeper.GetLease(ctx, msg.Name, msg.Lessor)
+	if !found || lease.Accepted() || !lease.Lessee.Equals(msg.Lessee) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no lease offer from %s to %s", msg.Name, msg.Lessor, msg.Lessee)
+	}
+
+	// Offers Go Stale Once Their Lessor Loses Control Of The Name
+	if !msg.Lessor.Equals(keeper.GetController(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s No Longer Controls %s", msg.Lessor, msg.Name)
+	}
+
+	lease.Start = ctx.BlockHeight()
+	lease.End = lease.Start + lease.Blocks
+
+	if err := checkSublease(ctx, keeper, msg.Name, msg.Lessee, lease.End); err != nil {
+		return nil, err
+	}
+
+	// The First Period, Or The Whole Fee, Is Paid Now
+	if !lease.Fee.IsZero() {
+		err := keeper.CoinKeeper.SendCoins(ctx, msg.Lessee, msg.Lessor, lease.Fee)
+
+		// Error Occurred
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lease.PaidThrough = lease.End
+	if lease.Period != 0 {
+		lease.PaidThrough = lease.Start + lease.Period
+
+		// The Later Periods Are Escrowed, So The Lessor Is Always Paid
+		lease.Escrow = lease.Total().Sub(lease.Fee)
+		if !lease.Escrow.IsZero() {
+			err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Lessee, types.ModuleName, lease.Escrow)
+
+			// Error Occurred
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	keeper.SetLease(ctx, lease)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptLease,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyLessor, msg.Lessor.String()),
+			sdk.NewAttribute(types.AttributeKeyLessee, msg.Lessee.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, lease.Total().String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", lease.End)),
+		),
+		messageEvent(msg.Lessee),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgEndLease(ctx sdk.Context, keeper Keeper, msg MsgEndLease) (*sdk.Result, error) {
+	lease, found := keeper.GetLease(ctx, msg.Name, msg.Lessor)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no lease from %s", msg.Name, msg.Lessor)
+	}
+
+	if !lease.Accepted() {
+		// Either Side May Call Off An Offer
+		if !msg.Signer.Equals(lease.Lessor) && !msg.Signer.Equals(lease.Lessee) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Not The Lessor Or Lessee Of The Offer")
+		}
+
+		keeper.DeleteLease(ctx, msg.Name, msg.Lessor)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeEndLease,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyLessor, lease.Lessor.String()),
+			sdk.NewAttribute(types.AttributeKeyLessee, lease.Lessee.String()),
+		))
+	} else {
+		// The Lessor Is Bound To The Terms, The Lessee May Give Up The Rest Of Them
+		if !msg.Signer.Equals(lease.Lessee) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Only The Lessee Can End A Lease Early")
+		}
+
+		if err := endLease(ctx, keeper, lease); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(messageEvent(msg.Signer))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// checkSublease checks a lease of name to lessee ending at end fits under
+// the leases name is already under: it ends with them at the latest, and
+// goes to someone not already holding the name
+func checkSublease(ctx sdk.Context, keeper Keeper, name string, lessee sdk.AccAddress, end int64) error {
+	if lessee.Equals(keeper.GetOwner(ctx, name)) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s already owns %s", lessee, name)
+	}
+
+	chain := keeper.GetLeaseChain(ctx, name)
+	for _, lease := range chain {
+		if lease.Lessee.Equals(lessee) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s already leases %s", lessee, name)
+		}
+	}
+
+	if len(chain) > types.MaxLeaseChain {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s can't be sublet more than %d times", name, types.MaxLeaseChain)
+	}
+
+	if len(chain) != 0 && end > chain[len(chain)-1].End {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "A sublease can't outlast its lease, which ends at block %d", chain[len(chain)-1].End)
+	}
+
+	return nil
+}
+
+// endLease hands control of the name back to the lease's lessor, ending
+// the subleases carved from it and refunding what the lessee escrowed
+func endLease(ctx sdk.Context, keeper Keeper, lease types.Lease) error {
+	if sublease, found := keeper.GetLease(ctx, lease.Name, lease.Lessee); found {
+		if sublease.Accepted() {
+			if err := endLease(ctx, keeper, sublease); err != nil {
+				return err
+			}
+		} else {
+			keeper.DeleteLease(ctx, sublease.Name, sublease.Lessor)
+		}
+	}
+
+	if !lease.Escrow.IsZero() {
+		err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lease.Lessee, lease.Escrow)
+
+		// Error Occurred
+		if err != nil {
+			return err
+		}
+	}
+
+	keeper.DeleteLease(ctx, lease.Name, lease.Lessor)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeEndLease,
+		sdk.NewAttribute(types.AttributeKeyName, lease.Name),
+		sdk.NewAttribute(types.AttributeKeyLessor, lease.Lessor.String()),
+		sdk.NewAttribute(types.AttributeKeyLessee, lease.Lessee.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, lease.Escrow.String()),
+	))
+
+	return nil
+}
+
+// openRecovery returns the unexpired recovery of name to newOwner, as long
+// as the name hasn't changed hands since it started
+func openRecovery(ctx sdk.Context, keeper Keeper, name string, newOwner sdk.AccAddress) (types.Recovery, error) {
+	recovery, found := keeper.GetRecovery(ctx, name, newOwner)
+	if !found || recovery.Expired(ctx.BlockTime()) {
+		return types.Recovery{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no recovery to %s under way", name, newOwner)
+	}
+
+	// Changing Hands Drops Recoveries, So This Only Guards Against Bad Genesis Data
+	if !recovery.Owner.Equals(keeper.GetOwner(ctx, name)) {
+		return types.Recovery{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s has changed hands since its recovery started", name)
+	}
+
+	return recovery, nil
+}
+
+// approveRecovery adds guardian's approval to recovery and stores it. Once
+// enough guardians approve, the delay the owner has to veto it starts.
+func approveRecovery(ctx sdk.Context, keeper Keeper, recovery types.Recovery, guardian sdk.AccAddress) {
+	recovery.Approvals = append(recovery.Approvals, guardian)
+
+	if len(recovery.Approvals) >= int(recovery.Threshold) {
+		recovery.ReadyAt = ctx.BlockTime().Add(recovery.Delay)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRecoveryApproved,
+			sdk.NewAttribute(types.AttributeKeyName, recovery.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, recovery.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, recovery.NewOwner.String()),
+			sdk.NewAttribute(types.AttributeKeyReadyAt, recovery.ReadyAt.UTC().Format(time.RFC3339)),
+		))
+	}
+
+	keeper.SetRecovery(ctx, recovery)
+}
+
+// approveProposal stores proposal, or carries it out once enough of the
+// name's owners have approved it
+func approveProposal(ctx sdk.Context, keeper Keeper, whois types.WhoIs, proposal types.Proposal) error {
+	if len(proposal.Approvals) < int(whois.Threshold) {
+		keeper.SetProposal(ctx, proposal)
+		return nil
+	}
+
+	if err := leased(ctx, keeper, proposal.Name); err != nil {
+		return err
+	}
+
+	action := proposal.Action
+	switch action.Type {
+	case types.ActionSetValue:
+		keeper.SetName(ctx, proposal.Name, action.Value)
+		keeper.DeleteProposal(ctx, proposal.Name, proposal.ID)
+	case types.ActionTransfer:
+		setOwners(ctx, keeper, proposal.Name, action.Owners, action.Threshold)
+	case types.ActionSell:
+		// The Sale Happens When The Buyer Buys The Name
+		proposal.Passed = true
+		keeper.SetProposal(ctx, proposal)
+	case types.ActionDelete:
+		if _, err := retireName(ctx, keeper, proposal.Name); err != nil {
+			return err
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown proposal action %q", action.Type)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeExecuteProposal,
+		sdk.NewAttribute(types.AttributeKeyName, proposal.Name),
+		sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+		sdk.NewAttribute(types.AttributeKeyAction, action.String()),
+	))
+
+	return nil
+}
+
+func handleMsgSelfAssess(ctx sdk.Context, keeper Keeper, msg MsgSelfAssess) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	whois := keeper.GetWhoIs(ctx, msg.Name)
+	if whois.IsMultiOwner() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Has Several Owners, It Can't Be Put Under Harberger Tax", msg.Name)
+	}
+
+	if !msg.Owner.Equals(whois.Owner) {
+		return nil, unauthorized(ctx, keeper, msg.Name, msg.Owner, "Incorrect Owner")
+	}
+
+	if err := leased(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	// Tax Owed At The Old Price Is Paid Before The New One Applies
+	if err := payTaxOwed(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	keeper.SetPrice(ctx, msg.Name, msg.Price)
+
+	// There Is No Opting Back Out, Else Owners Would Do So Whenever A Buyer Came Along
+	if !whois.SelfAssessed {
+		startTax(ctx, keeper, msg.Name)
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSelfAssess,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgDepositTax(ctx sdk.Context, keeper Keeper, msg MsgDepositTax) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	whois := keeper.GetWhoIs(ctx, msg.Name)
+	if !whois.SelfAssessed {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s Isn't Under Harberger Tax", msg.Name)
+	}
+
+	// Anyone May Top Up A Deposit, Though It Is Only Ever Refunded To The Owner
+	err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Depositor, types.ModuleName, msg.Amount)
+
+	// Error Occurred
+	if err != nil {
+		return nil, err
+	}
+
+	whois.Deposit = whois.Deposit.Add(msg.Amount...)
+	keeper.SetWhoIs(ctx, msg.Name, whois)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDepositTax,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, whois.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, whois.Deposit.String()),
+		),
+		messageEvent(msg.Depositor),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgWithdrawTax(ctx sdk.Context, keeper Keeper, msg MsgWithdrawTax) (*sdk.Result, error) {
+	if !keeper.IsNamePresent(ctx, msg.Name) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, msg.Name)
+	}
+
+	if !keeper.GetWhoIs(ctx, msg.Name).SelfAssessed {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s Isn't Under Harberger Tax", msg.Name)
+	}
+
+	if !msg.Owner.Equals(keeper.GetOwner(ctx, msg.Name)) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "Incorrect Owner")
+	}
+
+	// Only What Is Left Once The Tax Owed Is Paid Can Be Withdrawn
+	if err := payTaxOwed(ctx, keeper, msg.Name); err != nil {
+		return nil, err
+	}
+
+	whois := keeper.GetWhoIs(ctx, msg.Name)
+	if !whois.Deposit.IsAllGTE(msg.Amount) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Deposit Only Holds %s", whois.Deposit)
+	}
+
+	err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.Owner, msg.Amount)
+
+	// Error Occurred
+	if err != nil {
+		return nil, err
+	}
+
+	whois.Deposit = whois.Deposit.Sub(msg.Amount)
+	keeper.SetWhoIs(ctx, msg.Name, whois)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawTax,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, whois.Deposit.String()),
+		),
+		messageEvent(msg.Owner),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// startTax puts name under Harberger tax from the current block
+func startTax(ctx sdk.Context, keeper Keeper, name string) {
+	whois := keeper.GetWhoIs(ctx, name)
+	whois.SelfAssessed = true
+	keeper.SetWhoIs(ctx, name, whois)
+
+	keeper.SetTaxPaidThrough(ctx, name, ctx.BlockHeight())
+}
+
+// settleTax pays the tax name owes into the community pool out of its
+// deposit, foreclosing the name, and reporting so, if the deposit falls short
+func settleTax(ctx sdk.Context, keeper Keeper, name string) (bool, error) {
+	whois := keeper.GetWhoIs(ctx, name)
+	if !whois.SelfAssessed || whois.TaxPaidThrough >= ctx.BlockHeight() {
+		return false, nil
+	}
+
+	tax := keeper.GetTaxOwed(ctx, name)
+	if !whois.Deposit.IsAllGTE(tax) {
+		return true, foreclose(ctx, keeper, name)
+	}
+
+	if !tax.IsZero() {
+		if err := keeper.FundCommunityPool(ctx, tax); err != nil {
+			return false, err
+		}
+
+		whois.Deposit = whois.Deposit.Sub(tax)
+		keeper.SetWhoIs(ctx, name, whois)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePayTax,
+			sdk.NewAttribute(types.AttributeKeyName, name),
+			sdk.NewAttribute(types.AttributeKeyOwner, whois.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyTax, tax.String()),
+			sdk.NewAttribute(types.AttributeKeyDeposit, whois.Deposit.String()),
+		))
+	}
+
+	keeper.SetTaxPaidThrough(ctx, name, ctx.BlockHeight())
+	return false, nil
+}
+
+// payTaxOwed settles name's tax, failing rather than foreclosing the name
+// when its deposit falls short, so its owner can deposit more first
+func payTaxOwed(ctx sdk.Context, keeper Keeper, name string) error {
+	whois := keeper.GetWhoIs(ctx, name)
+	if !whois.SelfAssessed {
+		return nil
+	}
+
+	if tax := keeper.GetTaxOwed(ctx, name); !whois.Deposit.IsAllGTE(tax) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s Owes %s In Tax, More Than Its Deposit Holds", name, tax)
+	}
+
+	_, err := settleTax(ctx, keeper, name)
+	return err
+}
+
+// foreclose takes name from an owner whose deposit can't cover its tax,
+// paying what is left of the deposit, and the price escrowed when it was
+// registered, into the community pool
+func foreclose(ctx sdk.Context, keeper Keeper, name string) error {
+	whois := keeper.GetWhoIs(ctx, name)
+	if forfeit := whois.Deposit.Add(whois.Escrow...); !forfeit.IsZero() {
+		if err := keeper.FundCommunityPool(ctx, forfeit); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeForecloseName,
+		sdk.NewAttribute(types.AttributeKeyName, name),
+		sdk.NewAttribute(types.AttributeKeyOwner, whois.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPrice, whois.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyDeposit, whois.Deposit.String()),
+	))
+
+	releaseName(ctx, keeper, name)
+	return nil
+}
+
+// bidAuction makes msg's bid the leading bid on auction, escrowing it and
+// returning the bid it beat. The first bid, at least the falling price,
+// opens bidding, and any bid in the last blocks before the close pushes the
+// close back, so nobody can snipe the name without leaving time to answer.
+func bidAuction(ctx sdk.Context, keeper Keeper, auction types.Auction, msg MsgBuyName) (*sdk.Result, error) {
+	height := ctx.BlockHeight()
+	params := keeper.GetParams(ctx)
+
+	// The Close Block Only Awards The Name, Its EndBlocker Running After Any Bid In It
+	if auction.Bidding() && height >= auction.Close {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "Bidding On %s Closed At Block %d", msg.Name, auction.Close)
+	}
+
+	if auction.Bidding() {
+		if !msg.Bid.IsAllGT(auction.Bid) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Bid Didn't Surpass The Leading Bid %s", auction.Bid)
+		}
+	} else if auction.Price(height).IsAllGT(msg.Bid) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "Bid Didn't Meet The Auction's Current Price %s", auction.Price(height))
+	}
+
+	err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Buyer, types.ModuleName, msg.Bid)
+
+	// Error Occurred
+	if err != nil {
+		return nil, err
+	}
+
+	if auction.Bidding() {
+		err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, auction.Bid)
+
+		// Error Occurred
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		auction.Close = height + params.AuctionBidBlocks
+	}
+
+	// Bids Are Taken Up To The Block Before The Close, The Last Of The Window
+	var extension int64
+	if auction.Close-height <= params.AuctionExtendWindow {
+		extension = params.AuctionExtendBlocks
+		if extension > params.AuctionMaxExtension-auction.Extended {
+			extension = params.AuctionMaxExtension - auction.Extended
+		}
+	}
+
+	auction.Bidder = msg.Buyer
+	auction.Bid = msg.Bid
+	auction.Close += extension
+	auction.Extended += extension
+	keeper.SetAuction(ctx, auction)
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBidAuction,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyBidder, msg.Buyer.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, msg.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", auction.Close)),
+		),
+	}
+	if extension > 0 {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeExtendAuction,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", auction.Close)),
+			sdk.NewAttribute(types.AttributeKeyExtended, fmt.Sprintf("%d", auction.Extended)),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(append(events, messageEvent(msg.Buyer)))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// releaseName takes name from its owner and puts it back into circulation
+// through a Dutch auction, starting at a multiple of its last price
+func releaseName(ctx sdk.Context, keeper Keeper, name string) {
+	params := keeper.GetParams(ctx)
+	auction := types.NewAuction(name, keeper.GetPrice(ctx, name), params.AuctionStartMultiple, ctx.BlockHeight(), params.AuctionBlocks)
+
+	deleteName(ctx, keeper, name)
+	keeper.SetAuction(ctx, auction)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStartAuction,
+		sdk.NewAttribute(types.AttributeKeyName, name),
+		sdk.NewAttribute(types.AttributeKeyStartPrice, auction.StartPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyEndHeight, fmt.Sprintf("%d", auction.End)),
+	))
+}
+
+// retireName deletes name at its owners' request. The owner gets back the
+// tax deposit and the share of the price escrowed at registration that
+// params refund, the rest going to the community pool, and the owners alone
+// may register the name again until its cooldown ends.
+func retireName(ctx sdk.Context, keeper Keeper, name string) (sdk.Coins, error) {
+	if err := refundDeposit(ctx, keeper, name); err != nil {
+		return nil, err
+	}
+
+	whois := keeper.GetWhoIs(ctx, name)
+	params := keeper.GetParams(ctx)
+
+	refund, err := refundEscrow(ctx, keeper, name)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteName(ctx, keeper, name)
+
+	if params.DeleteCooldownBlocks > 0 {
+		keeper.SetCooldown(ctx, types.Cooldown{
+			Name: name,
+			Owners: whois.AllOwners(),
+			Until: ctx.BlockHeight() + params.DeleteCooldownBlocks,
+		})
+	}
+
+	return refund, nil
+}
+
+// refundDeposit returns what is left of name's tax deposit to its owner
+func refundDeposit(ctx sdk.Context, keeper Keeper, name string) error {
+	whois := keeper.GetWhoIs(ctx, name)
+	if whois.Deposit.IsZero() {
+		return nil
+	}
+
+	err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, whois.Owner, whois.Deposit)
+
+	// Error Occurred
+	if err != nil {
+		return err
+	}
+
+	whois.Deposit = nil
+	keeper.SetWhoIs(ctx, name, whois)
+	return nil
+}
+
+// refundEscrow settles the price escrowed when name was registered as its
+// owner gives it up, by deleting or selling it: the share params refund goes
+// back to the owner, the rest to the community pool
+func refundEscrow(ctx sdk.Context, keeper Keeper, name string) (sdk.Coins, error) {
+	whois := keeper.GetWhoIs(ctx, name)
+	if whois.Escrow.IsZero() {
+		return sdk.Coins{}, nil
+	}
+
+	refund, _ := sdk.NewDecCoinsFromCoins(whois.Escrow...).MulDec(keeper.GetParams(ctx).DeleteRefundRate).TruncateDecimal()
+	if !refund.IsZero() {
+		err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, whois.Owner, refund)
+
+		// Error Occurred
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if forfeit := whois.Escrow.Sub(refund); !forfeit.IsZero() {
+		if err := keeper.FundCommunityPool(ctx, forfeit); err != nil {
+			return nil, err
+		}
+	}
+
+	whois.Escrow = nil
+	keeper.SetWhoIs(ctx, name, whois)
+	return refund, nil
+}
+
+// setOwners hands name to owners, dropping what the previous owners set up
+func setOwners(ctx sdk.Context, keeper Keeper, name string, owners []sdk.AccAddress, threshold uint32) {
+	dropOwnership(ctx, keeper, name)
+	keeper.SetOwners(ctx, name, owners, threshold)
+}
+
+// deleteName removes name along with what its owners set up and its place
+// in the tax queue
+func deleteName(ctx sdk.Context, keeper Keeper, name string) {
+	dropOwnership(ctx, keeper, name)
+	keeper.UnqueueTax(ctx, name)
+	keeper.DeleteWhoIs(ctx, name)
+}
+
+// dropOwnership removes what name's owners set up on it, its operator
+// approvals, proposals, guardians, recoveries and lease offers, before it
+// changes hands
+func dropOwnership(ctx sdk.Context, keeper Keeper, name string) {
+	owner := keeper.GetOwner(ctx, name)
+
+	keeper.DeleteOperators(ctx, owner, name)
+	keeper.DeleteGuardianSet(ctx, owner, name)
+	keeper.DeleteProposals(ctx, name)
+	keeper.DeleteRecoveries(ctx, name)
+	keeper.DeleteLeases(ctx, name)
+}
+
+// leased explains that name can't change hands, nor be acted on by its
+// owners, until its lease ends
+func leased(ctx sdk.Context, keeper Keeper, name string) error {
+	chain := keeper.GetLeaseChain(ctx, name)
+	if len(chain) == 0 {
+		return nil
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Is Leased To %s Until Block %d", name, chain[len(chain)-1].Lessee, chain[0].End)
+}
+
+// unauthorized explains why signer can't act on name alone: if signer owns
+// it, the name may be leased out, and if it has several owners it takes a
+// proposal
+func unauthorized(ctx sdk.Context, keeper Keeper, name string, signer sdk.AccAddress, reason string) error {
+	whois := keeper.GetWhoIs(ctx, name)
+	if whois.IsOwner(signer) {
+		if err := leased(ctx, keeper, name); err != nil {
+			return err
+		}
+	}
+	if whois.IsMultiOwner() && whois.IsOwner(signer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s Needs %d Of Its %d Owners To Approve, Propose It Instead", name, whois.Threshold, len(whois.Owners))
+	}
+	return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, reason)
+}
+
+// equalAddrRecords reports whether a and b hold the same ADDR records, in the
+// same order, whatever their other records
+func equalAddrRecords(a, b []types.Record) bool {
+	addrs := func(records []types.Record) []string {
+		var values []string
+		for _, record := range records {
+			if record.Type == types.RecordTypeADDR {
+				values = append(values, record.Value)
+			}
+		}
+		return values
+	}
+
+	x, y := addrs(a), addrs(b)
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addrs []sdk.AccAddress, addr sdk.AccAddress) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// payOwners pays amount from payer to owners in equal shares, the first
+// owner also taking what doesn't divide evenly between them
+func payOwners(ctx sdk.Context, keeper Keeper, payer sdk.AccAddress, owners []sdk.AccAddress, amount sdk.Coins) error {
+	shares := make([]sdk.Coin, 0, len(amount))
+	for _, coin := range amount {
+		shares = append(shares, sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(len(owners)))))
+	}
+	share := sdk.NewCoins(shares...)
+
+	rest := amount
+	for _, owner := range owners[1:] {
+		if share.IsZero() {
+			break
+		}
+
+		if err := keeper.CoinKeeper.SendCoins(ctx, payer, owner, share); err != nil {
+			return err
+		}
+		rest = rest.Sub(share)
+	}
+
+	return keeper.CoinKeeper.SendCoins(ctx, payer, owners[0], rest)
+}
+
+func joinAddresses(addrs []sdk.AccAddress) string {
+	strs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		strs[i] = addr.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+func handleMsgBatch(ctx sdk.Context, keeper Keeper, msg MsgBatch) (*sdk.Result, error) {
+	var events sdk.Events
+
+	for i, op := range msg.Operations {
+		opMsg, err := op.Msg()
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "operation %d", i)
+		}
+
+		gasBefore := ctx.GasMeter().GasConsumed()
+
+		// A Failed Operation Fails The Tx, Which Reverts The Ones Before It
+		res, err := handleBatchOperation(ctx.WithEventManager(sdk.NewEventManager()), keeper, opMsg)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "operation %d", i)
+		}
+
+		// The Batch Emits A Single Message Event In Place Of One Per Operation
+		for _, event := range res.Events {
+			if event.Type != sdk.EventTypeMessage {
+				events = append(events, event)
+			}
+		}
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeBatchOperation,
+			sdk.NewAttribute(types.AttributeKeyIndex, fmt.Sprintf("%d", i)),
+			sdk.NewAttribute(types.AttributeKeyOperation, opMsg.Type()),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, fmt.Sprintf("%d", ctx.GasMeter().GasConsumed()-gasBefore)),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(append(events, messageEvent(msg.Signer)))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleBatchOperation runs one operation of a batch through the handler of
+// its own message
+func handleBatchOperation(ctx sdk.Context, keeper Keeper, msg sdk.Msg) (*sdk.Result, error) {
+	switch msg := msg.(type) {
+	case MsgSetName:
+		return handleMsgSetName(ctx, keeper, msg)
+	case MsgBuyName:
+		return handleMsgBuyName(ctx, keeper, msg)
+	case MsgDeleteName:
+		return handleMsgDeleteName(ctx, keeper, msg)
+	case MsgSetRecords:
+		return handleMsgSetRecords(ctx, keeper, msg)
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%T can't be batched", msg)
+	}
+}
+
+// Event Helpers
+
+// ownerAttributes names the owner of a name, and the operator when someone
+// other than the owner signed
+func ownerAttributes(owner, signer sdk.AccAddress) []sdk.Attribute {
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyOwner, owner.String())}
+	if !signer.Equals(owner) {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyOperator, signer.String()))
+	}
+	return attributes
+}
+
+// messageEvent tags the tx with the module and signer so clients can
+// subscribe to every nameservice change with a single query
+func messageEvent(sender sdk.AccAddress) sdk.Event {
+	return sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+	)
+}
@@ -0,0 +1,399 @@
+package nameservice
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/keeper"
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// testEnv runs messages through the handler against a keeper over an
+// in-memory store, the way DeliverTx would
+type testEnv struct {
+	keeper.TestInput
+	t       *testing.T
+	handler sdk.Handler
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	in := keeper.CreateTestInput()
+	return &testEnv{TestInput: in, t: t, handler: NewHandler(in.Keeper)}
+}
+
+// account creates an account holding amount nametoken
+func (e *testEnv) account(seed string, amount int64) sdk.AccAddress {
+	return e.NewAccount(seed, coins(amount))
+}
+
+// deliver runs msg, keeping its writes only when it succeeds
+func (e *testEnv) deliver(msg sdk.Msg) (*sdk.Result, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx, write := e.Ctx.CacheContext()
+	res, err := e.handler(ctx, msg)
+	if err == nil {
+		write()
+	}
+	return res, err
+}
+
+func (e *testEnv) must(msg sdk.Msg) *sdk.Result {
+	e.t.Helper()
+
+	res, err := e.deliver(msg)
+	if err != nil {
+		e.t.Fatalf("%s failed: %v", msg.Type(), err)
+	}
+	return res
+}
+
+// fail runs msg, expecting it to fail with an error wrapping target
+func (e *testEnv) fail(msg sdk.Msg, target *sdkerrors.Error) {
+	e.t.Helper()
+
+	_, err := e.deliver(msg)
+	if err == nil {
+		e.t.Fatalf("%s succeeded, want %v", msg.Type(), target)
+	}
+	if !target.Is(err) {
+		e.t.Fatalf("%s failed with %v, want %v", msg.Type(), err, target)
+	}
+}
+
+func (e *testEnv) balance(addr sdk.AccAddress) sdk.Coins {
+	return e.BankKeeper.GetCoins(e.Ctx, addr)
+}
+
+func (e *testEnv) assertBalance(addr sdk.AccAddress, want int64) {
+	e.t.Helper()
+
+	if got := e.balance(addr); !got.IsEqual(coins(want)) {
+		e.t.Fatalf("%s holds %s, want %s", addr, got, coins(want))
+	}
+}
+
+func coins(amount int64) sdk.Coins {
+	if amount == 0 {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin("nametoken", amount))
+}
+
+func TestSetRecordsAddrNeedsOwnerOrManage(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 100)
+	recordsOp := e.account("records", 0)
+	manageOp := e.account("manage", 0)
+	lessee := e.account("lessee", 100)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	e.must(NewMsgApproveOperator("alice", recordsOp, ScopeRecords, time.Time{}, owner))
+	e.must(NewMsgApproveOperator("alice", manageOp, ScopeManage, time.Time{}, owner))
+
+	a := NewRecord(types.RecordTypeA, "10.0.0.1", 60)
+	payOwner := NewRecord(types.RecordTypeADDR, owner.String(), 60)
+	payRecordsOp := NewRecord(types.RecordTypeADDR, recordsOp.String(), 60)
+
+	e.must(NewMsgSetRecords("alice", []Record{a, payOwner}, owner))
+
+	// Records Operators Manage Every Other Record, Leaving ADDR Records As They Are
+	e.must(NewMsgSetRecords("alice", []Record{NewRecord(types.RecordTypeA, "10.0.0.2", 60), payOwner}, recordsOp))
+	e.fail(NewMsgSetRecords("alice", []Record{a, payRecordsOp}, recordsOp), sdkerrors.ErrUnauthorized)
+	e.fail(NewMsgSetRecords("alice", []Record{a}, recordsOp), sdkerrors.ErrUnauthorized)
+
+	if got := e.Keeper.GetPaymentAddress(e.Ctx, "alice"); !got.Equals(owner) {
+		t.Fatalf("payments go to %s, want the owner", got)
+	}
+
+	// Manage Operators May Redirect Payments
+	e.must(NewMsgSetRecords("alice", []Record{a, payRecordsOp}, manageOp))
+	e.must(NewMsgSetRecords("alice", []Record{a, payOwner}, manageOp))
+
+	// A Lessee Controls Every Other Record, But Not Where Payments Go
+	e.must(NewMsgOfferLease("alice", lessee, 100, coins(1), 10, owner))
+	e.must(NewMsgAcceptLease("alice", owner, lessee))
+
+	e.must(NewMsgSetRecords("alice", []Record{NewRecord(types.RecordTypeTXT, "leased", 60), payOwner}, lessee))
+	e.fail(NewMsgSetRecords("alice", []Record{a, NewRecord(types.RecordTypeADDR, lessee.String(), 60)}, lessee), sdkerrors.ErrUnauthorized)
+
+	if got := e.Keeper.GetPaymentAddress(e.Ctx, "alice"); !got.Equals(owner) {
+		t.Fatalf("payments go to %s, want the owner", got)
+	}
+}
+
+// hasEvent reports whether res carries an event of type eventType
+func hasEvent(res *sdk.Result, eventType string) bool {
+	for _, event := range res.Events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMultiOwnerProposals(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 100)
+	a, b, c := e.account("a", 0), e.account("b", 0), e.account("c", 0)
+	buyer := e.account("buyer", 100)
+	stranger := e.account("stranger", 100)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	e.must(NewMsgSetOwners("alice", []sdk.AccAddress{a, b, c}, 2, owner))
+
+	// Above A Threshold Of One, No Owner Acts Alone
+	e.fail(NewMsgSetName("alice", "10.0.0.1", a), sdkerrors.ErrUnauthorized)
+	e.fail(NewMsgSetName("alice", "10.0.0.1", owner), sdkerrors.ErrUnauthorized)
+
+	id := e.Keeper.GetNextProposalID(e.Ctx)
+	e.must(NewMsgPropose("alice", ProposalAction{Type: ActionSetValue, Value: "10.0.0.1"}, time.Time{}, a))
+	if got := e.Keeper.GetName(e.Ctx, "alice"); got == "10.0.0.1" {
+		t.Fatal("a proposal was executed on the proposer's approval alone")
+	}
+
+	e.fail(NewMsgApproveProposal("alice", id, stranger), sdkerrors.ErrUnauthorized)
+	if res := e.must(NewMsgApproveProposal("alice", id, b)); !hasEvent(res, types.EventTypeExecuteProposal) {
+		t.Error("executing the proposal emitted no execute_proposal event")
+	}
+	if got := e.Keeper.GetName(e.Ctx, "alice"); got != "10.0.0.1" {
+		t.Fatalf("alice resolves to %q, want the proposed value", got)
+	}
+	e.fail(NewMsgApproveProposal("alice", id, c), sdkerrors.ErrUnknownRequest)
+
+	// An Approved Sale Goes Only To Its Buyer, At Its Price
+	id = e.Keeper.GetNextProposalID(e.Ctx)
+	e.must(NewMsgPropose("alice", ProposalAction{Type: ActionSell, Buyer: buyer, Price: coins(50)}, time.Time{}, a))
+	e.fail(NewMsgBuyName("alice", coins(50), buyer), sdkerrors.ErrUnauthorized)
+	e.must(NewMsgApproveProposal("alice", id, c))
+
+	e.fail(NewMsgBuyName("alice", coins(60), stranger), sdkerrors.ErrUnauthorized)
+	e.fail(NewMsgBuyName("alice", coins(40), buyer), sdkerrors.ErrInsufficientFunds)
+	e.must(NewMsgBuyName("alice", coins(50), buyer))
+
+	whois := e.Keeper.GetWhoIs(e.Ctx, "alice")
+	if !whois.Owner.Equals(buyer) || whois.IsMultiOwner() {
+		t.Fatalf("alice is held by %v, want the buyer alone", whois.AllOwners())
+	}
+	e.assertBalance(buyer, 50)
+
+	// The Owners Split The Price, The First Also Taking What Doesn't Divide
+	// And The Refunded Half Of The Escrow
+	e.assertBalance(a, 18+5)
+	e.assertBalance(b, 16)
+	e.assertBalance(c, 16)
+}
+
+func TestRecovery(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 100)
+	g1, g2, g3 := e.account("g1", 0), e.account("g2", 0), e.account("g3", 0)
+	newOwner := e.account("new", 0)
+	thief := e.account("thief", 0)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	e.must(NewMsgSetGuardians("alice", []sdk.AccAddress{g1, g2, g3}, 2, MinRecoveryDelay, owner))
+
+	// The Owner Vetoes Recoveries They Didn't Ask For
+	e.must(NewMsgStartRecovery("alice", thief, g3))
+	e.must(NewMsgVetoRecovery("alice", thief, owner))
+	e.fail(NewMsgApproveRecovery("alice", thief, g2), sdkerrors.ErrUnknownRequest)
+
+	e.fail(NewMsgStartRecovery("alice", newOwner, newOwner), sdkerrors.ErrUnauthorized)
+	e.must(NewMsgStartRecovery("alice", newOwner, g1))
+	e.fail(NewMsgFinalizeRecovery("alice", newOwner, newOwner), sdkerrors.ErrUnauthorized)
+
+	e.must(NewMsgApproveRecovery("alice", newOwner, g2))
+	e.fail(NewMsgApproveRecovery("alice", newOwner, g3), sdkerrors.ErrUnknownRequest)
+
+	// An Approved Recovery Still Waits Out The Delay, Giving The Owner Time To Veto
+	e.fail(NewMsgFinalizeRecovery("alice", newOwner, newOwner), sdkerrors.ErrUnauthorized)
+
+	e.Ctx = e.Ctx.WithBlockTime(e.Ctx.BlockTime().Add(MinRecoveryDelay))
+	if res := e.must(NewMsgFinalizeRecovery("alice", newOwner, newOwner)); !hasEvent(res, types.EventTypeRecoverName) {
+		t.Error("the recovery emitted no recover_name event")
+	}
+
+	if got := e.Keeper.GetOwner(e.Ctx, "alice"); !got.Equals(newOwner) {
+		t.Fatalf("alice is owned by %s, want the recovered owner", got)
+	}
+
+	// The Old Owner's Guardians Don't Carry Over
+	e.fail(NewMsgStartRecovery("alice", owner, g1), sdkerrors.ErrUnauthorized)
+}
+
+func TestBuySettlesSellersEscrow(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 100)
+	buyer := e.account("buyer", 100)
+
+	// Registering Escrows The Price
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	if got := e.ModuleBalance(); !got.IsEqual(coins(10)) {
+		t.Fatalf("module holds %s, want the registration price", got)
+	}
+
+	e.fail(NewMsgBuyName("alice", coins(9), buyer), sdkerrors.ErrInsufficientFunds)
+	e.must(NewMsgBuyName("alice", coins(30), buyer))
+
+	// The Seller Gets The Bid And The Share Of The Escrow A Deletion Would Refund, The Rest Going To The Community Pool
+	e.assertBalance(owner, 125)
+	e.assertBalance(buyer, 70)
+	if got := e.CommunityPool.Balance(e.Ctx); !got.IsEqual(coins(5)) {
+		t.Fatalf("community pool holds %s, want the forfeit half of the escrow", got)
+	}
+	if got := e.ModuleBalance(); !got.IsZero() {
+		t.Fatalf("module still holds %s after the sale", got)
+	}
+	if got := e.Keeper.GetWhoIs(e.Ctx, "alice").Escrow; !got.IsZero() {
+		t.Fatalf("the buyer inherited an escrow of %s", got)
+	}
+
+	e.must(NewMsgDeleteName("alice", buyer))
+	e.assertBalance(buyer, 70)
+}
+
+func TestDeleteRefundsAndCoolsDown(t *testing.T) {
+	e := newTestEnv(t)
+	e.setParams(func(p *Params) { p.DeleteCooldownBlocks = 5 })
+
+	owner := e.account("owner", 100)
+	stranger := e.account("stranger", 100)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	e.fail(NewMsgDeleteName("alice", stranger), sdkerrors.ErrUnauthorized)
+	e.must(NewMsgDeleteName("alice", owner))
+
+	// Half The Escrowed Price Is Refunded, The Rest Forfeit To The Community Pool
+	e.assertBalance(owner, 95)
+	if got := e.CommunityPool.Balance(e.Ctx); !got.IsEqual(coins(5)) {
+		t.Fatalf("community pool holds %s, want the forfeit half", got)
+	}
+
+	// Only The Last Owner May Register It Until The Cooldown Ends
+	e.fail(NewMsgBuyName("alice", coins(1), stranger), sdkerrors.ErrUnauthorized)
+
+	e.endBlocks(6)
+	e.must(NewMsgBuyName("alice", coins(1), stranger))
+	if got := e.Keeper.GetOwner(e.Ctx, "alice"); !got.Equals(stranger) {
+		t.Fatalf("alice is owned by %s, want the stranger", got)
+	}
+}
+
+// batch wraps msgs into a MsgBatch signed by signer
+func (e *testEnv) batch(signer sdk.AccAddress, msgs ...sdk.Msg) MsgBatch {
+	e.t.Helper()
+
+	operations := make([]BatchOperation, len(msgs))
+	for i, msg := range msgs {
+		op, err := NewBatchOperation(msg)
+		if err != nil {
+			e.t.Fatal(err)
+		}
+		operations[i] = op
+	}
+	return NewMsgBatch(operations, signer)
+}
+
+func TestBatchRollsBackOnFailure(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 100)
+
+	// The Last Operation Outbids The Owner's Balance, Undoing The Two Before It
+	e.fail(e.batch(owner,
+		NewMsgBuyName("alice", coins(10), owner),
+		NewMsgSetName("alice", "10.0.0.1", owner),
+		NewMsgBuyName("bob", coins(200), owner),
+	), sdkerrors.ErrInsufficientFunds)
+
+	if e.Keeper.IsNamePresent(e.Ctx, "alice") {
+		t.Fatal("alice was registered by a batch that failed")
+	}
+	e.assertBalance(owner, 100)
+
+	res := e.must(e.batch(owner,
+		NewMsgBuyName("alice", coins(10), owner),
+		NewMsgSetName("alice", "10.0.0.1", owner),
+	))
+	if got := e.Keeper.GetName(e.Ctx, "alice"); got != "10.0.0.1" {
+		t.Fatalf("alice resolves to %q, want the batch's value", got)
+	}
+
+	operations := 0
+	for _, event := range res.Events {
+		if event.Type == types.EventTypeBatchOperation {
+			operations++
+		}
+	}
+	if operations != 2 {
+		t.Errorf("got %d batch_operation events, want one per operation", operations)
+	}
+}
+
+func TestBatchAuthorizesEachOperation(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 100)
+	stranger := e.account("stranger", 100)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+	e.must(NewMsgBuyName("zed", coins(10), stranger))
+
+	// Signing The Batch Doesn't Stand In For Owning Each Name It Touches
+	e.fail(e.batch(stranger,
+		NewMsgSetName("zed", "10.0.0.2", stranger),
+		NewMsgSetName("alice", "10.0.0.1", stranger),
+	), sdkerrors.ErrUnauthorized)
+
+	if got := e.Keeper.GetName(e.Ctx, "zed"); got == "10.0.0.2" {
+		t.Fatal("zed kept the value set before the unauthorized operation")
+	}
+	if got := e.Keeper.GetName(e.Ctx, "alice"); got == "10.0.0.1" {
+		t.Fatal("alice was set by someone who doesn't own it")
+	}
+
+	// Nor Can It Carry Operations Signed By Anyone Else
+	e.fail(e.batch(stranger, NewMsgSetName("alice", "10.0.0.1", owner)), sdkerrors.ErrUnauthorized)
+
+	// An Approved Operator Acts On The Name Inside A Batch As Outside One
+	e.must(NewMsgApproveOperator("alice", stranger, ScopeManage, time.Time{}, owner))
+	e.must(e.batch(stranger, NewMsgSetName("alice", "10.0.0.1", stranger)))
+	if got := e.Keeper.GetName(e.Ctx, "alice"); got != "10.0.0.1" {
+		t.Fatalf("alice resolves to %q, want the operator's value", got)
+	}
+}
+
+func TestSendToName(t *testing.T) {
+	e := newTestEnv(t)
+	owner := e.account("owner", 100)
+	payee := e.account("payee", 0)
+	sender := e.account("sender", 50)
+
+	e.must(NewMsgBuyName("alice", coins(10), owner))
+
+	// Paid To The Owner, Until An ADDR Record Names Another Account
+	if res := e.must(NewMsgSendToName("alice", coins(5), sender)); !hasEvent(res, types.EventTypeSendToName) {
+		t.Error("sending to a name emitted no send_to_name event")
+	}
+	e.assertBalance(owner, 95)
+
+	e.must(NewMsgSetRecords("alice", []Record{NewRecord(types.RecordTypeADDR, payee.String(), 60)}, owner))
+	e.must(NewMsgSendToName("alice", coins(5), sender))
+	e.assertBalance(payee, 5)
+	e.assertBalance(owner, 95)
+
+	// Nothing Moves When The Send Is Rejected
+	e.fail(NewMsgSendToName("bob", coins(5), sender), types.ErrNameDoesNotExist)
+	e.fail(NewMsgSendToName("alice", coins(100), sender), sdkerrors.ErrInsufficientFunds)
+
+	e.BankKeeper.SetSendEnabled(e.Ctx, false)
+	e.fail(NewMsgSendToName("alice", coins(5), sender), bank.ErrSendDisabled)
+
+	e.assertBalance(sender, 40)
+	e.assertBalance(payee, 5)
+}
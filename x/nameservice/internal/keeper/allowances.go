@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Fee Allowance Getters & Setters
+
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, grantee, granter sdk.AccAddress) (types.FeeAllowance, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.FeeAllowanceKey(grantee, granter))
+	if bz == nil {
+		return types.FeeAllowance{}, false
+	}
+
+	var allowance types.FeeAllowance
+	k.cdc.MustUnmarshalBinaryBare(bz, &allowance)
+	return allowance, true
+}
+
+func (k Keeper) SetFeeAllowance(ctx sdk.Context, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeeAllowanceKey(allowance.Grantee, allowance.Granter), k.cdc.MustMarshalBinaryBare(allowance))
+}
+
+func (k Keeper) DeleteFeeAllowance(ctx sdk.Context, grantee, granter sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.FeeAllowanceKey(grantee, granter))
+}
+
+// GetFeeAllowances returns every allowance granted to grantee, ordered by granter
+func (k Keeper) GetFeeAllowances(ctx sdk.Context, grantee sdk.AccAddress) []types.FeeAllowance {
+	return k.iterateFeeAllowances(ctx, types.FeeAllowancesKey(grantee))
+}
+
+// GetAllFeeAllowances returns every allowance in the store, expired or not
+func (k Keeper) GetAllFeeAllowances(ctx sdk.Context) []types.FeeAllowance {
+	return k.iterateFeeAllowances(ctx, types.FeeAllowanceKeyPrefix)
+}
+
+// UseFeeAllowance pays fee to grantee out of the first of its allowances,
+// by granter, that is unexpired and can cover all of it. It reports false,
+// paying nothing, when none can.
+func (k Keeper) UseFeeAllowance(ctx sdk.Context, grantee sdk.AccAddress, fee sdk.Coins) (bool, error) {
+	for _, allowance := range k.GetFeeAllowances(ctx, grantee) {
+		if !allowance.Covers(fee, ctx.BlockTime()) {
+			continue
+		}
+
+		if err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, grantee, fee); err != nil {
+			return false, err
+		}
+
+		allowance.Remaining = allowance.Remaining.Sub(fee)
+		k.SetFeeAllowance(ctx, allowance)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (k Keeper) iterateFeeAllowances(ctx sdk.Context, prefix []byte) []types.FeeAllowance {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var allowances []types.FeeAllowance
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.FeeAllowance
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+	return allowances
+}
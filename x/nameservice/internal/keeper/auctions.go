@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Auction Getters & Setters
+
+func (k Keeper) GetAuction(ctx sdk.Context, name string) (types.Auction, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.AuctionKey(name))
+	if bz == nil {
+		return types.Auction{}, false
+	}
+
+	var auction types.Auction
+	k.cdc.MustUnmarshalBinaryBare(bz, &auction)
+	return auction, true
+}
+
+// SetAuction stores auction and queues it for the height it ends, moving
+// it back in the queue as bids push its close back
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.Auction) {
+	k.DeleteAuction(ctx, auction.Name)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AuctionKey(auction.Name), k.cdc.MustMarshalBinaryBare(auction))
+	store.Set(types.AuctionQueueKey(auction.Closes(), auction.Name), []byte{})
+}
+
+// DeleteAuction removes name's auction along with its place in the queue
+func (k Keeper) DeleteAuction(ctx sdk.Context, name string) {
+	auction, found := k.GetAuction(ctx, name)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AuctionKey(name))
+	store.Delete(types.AuctionQueueKey(auction.Closes(), name))
+}
+
+// GetAllAuctions returns every auction in the store, ordered by name
+func (k Keeper) GetAllAuctions(ctx sdk.Context) []types.Auction {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.AuctionKeyPrefix)
+	defer iterator.Close()
+
+	var auctions []types.Auction
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.Auction
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &auction)
+		auctions = append(auctions, auction)
+	}
+	return auctions
+}
+
+// DequeueAuctions removes the auctions ending by height, returning them in
+// the order they ended
+func (k Keeper) DequeueAuctions(ctx sdk.Context, height int64) []types.Auction {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.AuctionQueueKeyPrefix, types.AuctionQueueHeightKey(height+1))
+	defer iterator.Close()
+
+	var names []string
+	for ; iterator.Valid(); iterator.Next() {
+		names = append(names, string(iterator.Key()[len(types.AuctionQueueKeyPrefix)+8:]))
+	}
+
+	var auctions []types.Auction
+	for _, name := range names {
+		if auction, found := k.GetAuction(ctx, name); found {
+			auctions = append(auctions, auction)
+		}
+		k.DeleteAuction(ctx, name)
+	}
+	return auctions
+}
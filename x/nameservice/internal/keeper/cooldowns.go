@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Cooldown Getters & Setters
+
+func (k Keeper) GetCooldown(ctx sdk.Context, name string) (types.Cooldown, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.CooldownKey(name))
+	if bz == nil {
+		return types.Cooldown{}, false
+	}
+
+	var cooldown types.Cooldown
+	k.cdc.MustUnmarshalBinaryBare(bz, &cooldown)
+	return cooldown, true
+}
+
+// SetCooldown stores cooldown and queues it for the height it ends
+func (k Keeper) SetCooldown(ctx sdk.Context, cooldown types.Cooldown) {
+	k.DeleteCooldown(ctx, cooldown.Name)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CooldownKey(cooldown.Name), k.cdc.MustMarshalBinaryBare(cooldown))
+	store.Set(types.CooldownQueueKey(cooldown.Until, cooldown.Name), []byte{})
+}
+
+// DeleteCooldown removes name's cooldown along with its place in the queue
+func (k Keeper) DeleteCooldown(ctx sdk.Context, name string) {
+	cooldown, found := k.GetCooldown(ctx, name)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CooldownKey(name))
+	store.Delete(types.CooldownQueueKey(cooldown.Until, name))
+}
+
+// GetAllCooldowns returns every cooldown in the store, ordered by name
+func (k Keeper) GetAllCooldowns(ctx sdk.Context) []types.Cooldown {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.CooldownKeyPrefix)
+	defer iterator.Close()
+
+	var cooldowns []types.Cooldown
+	for ; iterator.Valid(); iterator.Next() {
+		var cooldown types.Cooldown
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &cooldown)
+		cooldowns = append(cooldowns, cooldown)
+	}
+	return cooldowns
+}
+
+// DequeueCooldowns removes the cooldowns ending by height, returning them in
+// the order they ended
+func (k Keeper) DequeueCooldowns(ctx sdk.Context, height int64) []types.Cooldown {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.CooldownQueueKeyPrefix, types.CooldownQueueHeightKey(height+1))
+	defer iterator.Close()
+
+	var names []string
+	for ; iterator.Valid(); iterator.Next() {
+		names = append(names, string(iterator.Key()[len(types.CooldownQueueKeyPrefix)+8:]))
+	}
+
+	var cooldowns []types.Cooldown
+	for _, name := range names {
+		if cooldown, found := k.GetCooldown(ctx, name); found {
+			cooldowns = append(cooldowns, cooldown)
+		}
+		k.DeleteCooldown(ctx, name)
+	}
+	return cooldowns
+}
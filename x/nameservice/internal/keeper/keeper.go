@@ -8,17 +8,25 @@ import (
 
 // Keeper of the nameservice store
 type Keeper struct {
+	AccountKeeper	types.AccountKeeper
 	CoinKeeper	types.BankKeeper
+	SupplyKeeper	types.SupplyKeeper
+	DistrKeeper	types.DistrKeeper
 	storeKey	sdk.StoreKey
 	cdc 		*codec.Codec
+	paramspace	types.ParamSubspace
 }
 
 // Keeper Constructor
-func NewKeeper(coinkeeper types.BankKeeper, storekey sdk.StoreKey, cdc *codec.Codec) Keeper {
+func NewKeeper(accountkeeper types.AccountKeeper, coinkeeper types.BankKeeper, supplykeeper types.SupplyKeeper, distrkeeper types.DistrKeeper, storekey sdk.StoreKey, cdc *codec.Codec, paramspace types.ParamSubspace) Keeper {
 	return Keeper {
+		AccountKeeper: accountkeeper,
 		CoinKeeper: coinkeeper,
+		SupplyKeeper: supplykeeper,
+		DistrKeeper: distrkeeper,
 		storeKey: storekey,
 		cdc: cdc,
+		paramspace: paramspace.WithKeyTable(types.ParamKeyTable()),
 	}
 }
 
@@ -31,7 +39,7 @@ func (k Keeper) SetWhoIs(ctx sdk.Context, name string, w types.WhoIs) {
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte(name), k.cdc.MustMarshalBinaryBare(w))
+	store.Set(types.WhoIsKey(name), k.cdc.MustMarshalBinaryBare(w))
 }
 
 func (k Keeper) GetWhoIs(ctx sdk.Context, name string) types.WhoIs {
@@ -42,7 +50,7 @@ func (k Keeper) GetWhoIs(ctx sdk.Context, name string) types.WhoIs {
 		return types.NewWhoIs()
 	}
 
-	bz := store.Get([]byte(name))
+	bz := store.Get(types.WhoIsKey(name))
 
 	var whoIs types.WhoIs
 
@@ -52,7 +60,7 @@ func (k Keeper) GetWhoIs(ctx sdk.Context, name string) types.WhoIs {
 
 func (k Keeper) DeleteWhoIs(ctx sdk.Context, name string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete([]byte(name))
+	store.Delete(types.WhoIsKey(name))
 }
 
 // Name Getter & Setter & Bool & Iterator
@@ -68,12 +76,13 @@ func (k Keeper) SetName(ctx sdk.Context, name string, value string) {
 
 func (k Keeper) IsNamePresent(ctx sdk.Context, name string) bool {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has([]byte(name))
+	return store.Has(types.WhoIsKey(name))
 }
 
+// Only Visits Names, Not The Operator Approvals Kept Alongside Them
 func (k Keeper) GetNamesIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
-	return sdk.KVStorePrefixIterator(store, []byte{})
+	return store.Iterator(types.NamesKeyStart, types.NamesKeyEnd)
 }
 
 // Owner Getter, Setter, & Bool
@@ -82,9 +91,28 @@ func (k Keeper) GetOwner(ctx sdk.Context, name string) sdk.AccAddress {
 	return k.GetWhoIs(ctx, name).Owner
 }
 
+// SetOwner Hands The Name To A Single Owner, Dropping Any Set Of Owners
 func (k Keeper) SetOwner(ctx sdk.Context, name string, owner sdk.AccAddress) {
 	whois := k.GetWhoIs(ctx, name)
 	whois.Owner = owner 
+	whois.Owners = nil
+	whois.Threshold = 0
+	k.SetWhoIs(ctx, name, whois)
+}
+
+// SetOwners Hands The Name To Owners, Threshold Of Whom Must Approve What Is
+// Done To It. The First Owner Is Paid For Its Sale. A Single Owner Is Kept As
+// SetOwner Would.
+func (k Keeper) SetOwners(ctx sdk.Context, name string, owners []sdk.AccAddress, threshold uint32) {
+	if len(owners) == 1 {
+		k.SetOwner(ctx, name, owners[0])
+		return
+	}
+
+	whois := k.GetWhoIs(ctx, name)
+	whois.Owner = owners[0]
+	whois.Owners = owners
+	whois.Threshold = threshold
 	k.SetWhoIs(ctx, name, whois)
 }
 
@@ -104,6 +132,25 @@ func (k Keeper) SetPrice(ctx sdk.Context, name string, price sdk.Coins) {
 	k.SetWhoIs(ctx, name, whois)
 }
 
+// Records Getter & Setter
+
+func (k Keeper) GetRecords(ctx sdk.Context, name string) []types.Record {
+	return k.GetWhoIs(ctx, name).Records
+}
+
+func (k Keeper) SetRecords(ctx sdk.Context, name string, records []types.Record) {
+	whois := k.GetWhoIs(ctx, name)
+	whois.Records = records
+	k.SetWhoIs(ctx, name, whois)
+}
+
+// Payment Address Getter
+
+// GetPaymentAddress Returns The Account Designated By The Name's First ADDR Record, Or Its Owner
+func (k Keeper) GetPaymentAddress(ctx sdk.Context, name string) sdk.AccAddress {
+	return k.GetWhoIs(ctx, name).PaymentAddress()
+}
+
 
 
 
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Lease Getters & Setters
+
+func (k Keeper) GetLease(ctx sdk.Context, name string, lessor sdk.AccAddress) (types.Lease, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LeaseKey(name, lessor))
+	if bz == nil {
+		return types.Lease{}, false
+	}
+
+	var lease types.Lease
+	k.cdc.MustUnmarshalBinaryBare(bz, &lease)
+	return lease, true
+}
+
+// SetLease stores lease and, once accepted, queues it for the height its
+// next payment or end is due
+func (k Keeper) SetLease(ctx sdk.Context, lease types.Lease) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LeaseKey(lease.Name, lease.Lessor), k.cdc.MustMarshalBinaryBare(lease))
+
+	if lease.Accepted() {
+		store.Set(types.LeaseQueueKey(lease.PaidThrough, lease.Name, lease.Lessor), []byte{})
+	}
+}
+
+// DeleteLease removes the lease lessor gave on name along with its place in
+// the queue
+func (k Keeper) DeleteLease(ctx sdk.Context, name string, lessor sdk.AccAddress) {
+	lease, found := k.GetLease(ctx, name, lessor)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LeaseKey(name, lessor))
+
+	if lease.Accepted() {
+		store.Delete(types.LeaseQueueKey(lease.PaidThrough, name, lessor))
+	}
+}
+
+// DeleteLeases removes every lease of name. Names change hands only while
+// unleased, so only offers are left to remove.
+func (k Keeper) DeleteLeases(ctx sdk.Context, name string) {
+	for _, lease := range k.iterateLeases(ctx, types.LeasesKey(name)) {
+		k.DeleteLease(ctx, name, lease.Lessor)
+	}
+}
+
+// GetLeases returns every lease and lease offer of name, ordered by lessor
+func (k Keeper) GetLeases(ctx sdk.Context, name string) []types.Lease {
+	return k.iterateLeases(ctx, types.LeasesKey(name))
+}
+
+// GetAllLeases returns every lease in the store
+func (k Keeper) GetAllLeases(ctx sdk.Context) []types.Lease {
+	return k.iterateLeases(ctx, types.LeaseKeyPrefix)
+}
+
+// GetLeaseChain returns the active leases of name, its owner's first and
+// then each sublease of the one before it
+func (k Keeper) GetLeaseChain(ctx sdk.Context, name string) []types.Lease {
+	var chain []types.Lease
+
+	// Leases Never Go Back To Someone Holding The Name, So The Chain Ends
+	lessor := k.GetOwner(ctx, name)
+	for !lessor.Empty() && len(chain) <= types.MaxLeaseChain {
+		lease, found := k.GetLease(ctx, name, lessor)
+		if !found || !lease.Active(ctx.BlockHeight()) {
+			break
+		}
+
+		chain = append(chain, lease)
+		lessor = lease.Lessee
+	}
+	return chain
+}
+
+// GetController returns who controls name's value and records: the lessee
+// of its latest active sublease, else its owner
+func (k Keeper) GetController(ctx sdk.Context, name string) sdk.AccAddress {
+	chain := k.GetLeaseChain(ctx, name)
+	if len(chain) == 0 {
+		return k.GetOwner(ctx, name)
+	}
+	return chain[len(chain)-1].Lessee
+}
+
+// IsLeased reports whether name's owner has leased it out
+func (k Keeper) IsLeased(ctx sdk.Context, name string) bool {
+	return len(k.GetLeaseChain(ctx, name)) != 0
+}
+
+// DequeueLeases takes the leases due by height off the queue, in the order
+// they fell due
+func (k Keeper) DequeueLeases(ctx sdk.Context, height int64) []types.Lease {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.LeaseQueueKeyPrefix, types.LeaseQueueHeightKey(height+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	var leases []types.Lease
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		name, lessor := types.SplitLeaseQueueKey(iterator.Key())
+		if lease, found := k.GetLease(ctx, name, lessor); found {
+			leases = append(leases, lease)
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return leases
+}
+
+func (k Keeper) iterateLeases(ctx sdk.Context, prefix []byte) []types.Lease {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var leases []types.Lease
+	for ; iterator.Valid(); iterator.Next() {
+		var lease types.Lease
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &lease)
+		leases = append(leases, lease)
+	}
+	return leases
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Operator Approval Getters & Setters
+
+// SetOperator stores approval, replacing the one its owner gave the same
+// operator on the same name
+func (k Keeper) SetOperator(ctx sdk.Context, approval types.OperatorApproval) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OperatorKey(approval.Owner, approval.Name, approval.Operator), k.cdc.MustMarshalBinaryBare(approval))
+}
+
+func (k Keeper) HasOperator(ctx sdk.Context, owner sdk.AccAddress, name string, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.OperatorKey(owner, name, operator))
+}
+
+func (k Keeper) DeleteOperator(ctx sdk.Context, owner sdk.AccAddress, name string, operator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OperatorKey(owner, name, operator))
+}
+
+// DeleteOperators removes the approvals owner gave on name, leaving those on
+// all its names
+func (k Keeper) DeleteOperators(ctx sdk.Context, owner sdk.AccAddress, name string) {
+	for _, approval := range k.getOperators(ctx, owner, name) {
+		k.DeleteOperator(ctx, owner, name, approval.Operator)
+	}
+}
+
+// GetOperators returns the unexpired approvals covering name: those its
+// owner gave on it, then those on all the owner's names. Names with several
+// owners have none.
+func (k Keeper) GetOperators(ctx sdk.Context, name string) []types.OperatorApproval {
+	whois := k.GetWhoIs(ctx, name)
+	if whois.Owner.Empty() || whois.IsMultiOwner() {
+		return nil
+	}
+
+	var approvals []types.OperatorApproval
+	for _, approval := range append(k.getOperators(ctx, whois.Owner, name), k.getOperators(ctx, whois.Owner, "")...) {
+		if !approval.Expired(ctx.BlockTime()) {
+			approvals = append(approvals, approval)
+		}
+	}
+	return approvals
+}
+
+// ActsForOwners reports whether signer may act on name without a proposal:
+// as its single owner, or as one of its owners when one is enough
+func (k Keeper) ActsForOwners(ctx sdk.Context, name string, signer sdk.AccAddress) bool {
+	whois := k.GetWhoIs(ctx, name)
+	if whois.IsMultiOwner() {
+		return whois.Threshold <= 1 && whois.IsOwner(signer)
+	}
+
+	return !whois.Owner.Empty() && signer.Equals(whois.Owner)
+}
+
+// IsAuthorized reports whether signer may act on name within scope. Whoever
+// acts for its owners always may, an operator while an unexpired approval
+// covers scope. Operators don't act on names with several owners. While the
+// name is leased, only the lessee in control may.
+func (k Keeper) IsAuthorized(ctx sdk.Context, name string, signer sdk.AccAddress, scope string) bool {
+	whois := k.GetWhoIs(ctx, name)
+	if whois.Owner.Empty() {
+		return false
+	}
+
+	if chain := k.GetLeaseChain(ctx, name); len(chain) != 0 {
+		return signer.Equals(chain[len(chain)-1].Lessee)
+	}
+
+	if k.ActsForOwners(ctx, name, signer) {
+		return true
+	}
+
+	if whois.IsMultiOwner() {
+		return false
+	}
+
+	return k.isApproved(ctx, whois.Owner, name, signer, scope)
+}
+
+// MayDirectPayments reports whether signer may change where coins sent to
+// name are paid (its ADDR records): whoever acts for its owners, or an
+// operator approved to manage it. Lessees never may, the payments being the
+// owners', nor may operators approved for records only.
+func (k Keeper) MayDirectPayments(ctx sdk.Context, name string, signer sdk.AccAddress) bool {
+	whois := k.GetWhoIs(ctx, name)
+	if whois.Owner.Empty() {
+		return false
+	}
+
+	if k.ActsForOwners(ctx, name, signer) {
+		return true
+	}
+
+	return !whois.IsMultiOwner() && k.isApproved(ctx, whois.Owner, name, signer, types.ScopeManage)
+}
+
+// isApproved reports whether owner approved operator within scope, on name
+// or on all its names, and the approval hasn't expired
+func (k Keeper) isApproved(ctx sdk.Context, owner sdk.AccAddress, name string, operator sdk.AccAddress, scope string) bool {
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range [][]byte{types.OperatorKey(owner, name, operator), types.OperatorKey(owner, "", operator)} {
+		bz := store.Get(key)
+		if bz == nil {
+			continue
+		}
+
+		var approval types.OperatorApproval
+		k.cdc.MustUnmarshalBinaryBare(bz, &approval)
+
+		if approval.Allows(scope) && !approval.Expired(ctx.BlockTime()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetAllOperators returns every approval in the store, expired or not
+func (k Keeper) GetAllOperators(ctx sdk.Context) []types.OperatorApproval {
+	return k.iterateOperators(ctx, types.OperatorKeyPrefix)
+}
+
+func (k Keeper) getOperators(ctx sdk.Context, owner sdk.AccAddress, name string) []types.OperatorApproval {
+	return k.iterateOperators(ctx, types.OperatorsKey(owner, name))
+}
+
+func (k Keeper) iterateOperators(ctx sdk.Context, prefix []byte) []types.OperatorApproval {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var approvals []types.OperatorApproval
+	for ; iterator.Valid(); iterator.Next() {
+		var approval types.OperatorApproval
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &approval)
+		approvals = append(approvals, approval)
+	}
+	return approvals
+}
@@ -1,12 +1,9 @@
 package keeper
-/*
-// TODO: Define if your module needs Parameters, if not this can be deleted
 
 import (
-	"time"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/arjunandra/x/x/nameservice/internal/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
 )
 
 // GetParams returns the total set of nameservice parameters.
@@ -19,4 +16,3 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramspace.SetParamSet(ctx, &params)
 }
-*/
\ No newline at end of file
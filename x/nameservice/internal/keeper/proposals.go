@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Proposal Getters & Setters
+
+func (k Keeper) GetProposal(ctx sdk.Context, name string, id uint64) (types.Proposal, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ProposalKey(name, id))
+	if bz == nil {
+		return types.Proposal{}, false
+	}
+
+	var proposal types.Proposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProposalKey(proposal.Name, proposal.ID), k.cdc.MustMarshalBinaryBare(proposal))
+}
+
+func (k Keeper) DeleteProposal(ctx sdk.Context, name string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ProposalKey(name, id))
+}
+
+// DeleteProposals removes every proposal on name, as they were made to owners
+// who no longer hold it
+func (k Keeper) DeleteProposals(ctx sdk.Context, name string) {
+	for _, proposal := range k.iterateProposals(ctx, types.ProposalsKey(name)) {
+		k.DeleteProposal(ctx, name, proposal.ID)
+	}
+}
+
+// PruneProposals removes the proposals on name that have expired
+func (k Keeper) PruneProposals(ctx sdk.Context, name string) {
+	for _, proposal := range k.iterateProposals(ctx, types.ProposalsKey(name)) {
+		if proposal.Expired(ctx.BlockTime()) {
+			k.DeleteProposal(ctx, name, proposal.ID)
+		}
+	}
+}
+
+// GetProposals returns the unexpired proposals on name, oldest first
+func (k Keeper) GetProposals(ctx sdk.Context, name string) []types.Proposal {
+	var proposals []types.Proposal
+	for _, proposal := range k.iterateProposals(ctx, types.ProposalsKey(name)) {
+		if !proposal.Expired(ctx.BlockTime()) {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return proposals
+}
+
+// GetApprovedSale returns the unexpired sale of name to buyer its owners
+// approved, if there is one
+func (k Keeper) GetApprovedSale(ctx sdk.Context, name string, buyer sdk.AccAddress) (types.Proposal, bool) {
+	for _, proposal := range k.GetProposals(ctx, name) {
+		if proposal.Passed && proposal.Action.Type == types.ActionSell && proposal.Action.Buyer.Equals(buyer) {
+			return proposal, true
+		}
+	}
+	return types.Proposal{}, false
+}
+
+// GetAllProposals returns every proposal in the store, expired or not
+func (k Keeper) GetAllProposals(ctx sdk.Context) []types.Proposal {
+	return k.iterateProposals(ctx, types.ProposalKeyPrefix)
+}
+
+// GetNextProposalID returns the ID the next proposal will be given
+func (k Keeper) GetNextProposalID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.NextProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextProposalIDKey, bz)
+}
+
+func (k Keeper) iterateProposals(ctx sdk.Context, prefix []byte) []types.Proposal {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var proposals []types.Proposal
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	return proposals
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,6 +16,19 @@ const (
 	QueryResolve = "resolve"
 	QueryWhoIs = "whois"
 	QueryNames = "names"
+	QueryRecords = "records"
+	QueryAddress = "address"
+	QueryOwned = "owned"
+	QueryOwnedBy = "owned_by"
+	QueryOperators = "operators"
+	QueryFeeAllowances = "fee_allowances"
+	QueryProposals = "proposals"
+	QueryGuardians = "guardians"
+	QueryRecoveries = "recoveries"
+	QueryLeases = "leases"
+	QueryParams = "params"
+	QueryAuction = "auction"
+	QueryStatus = "status"
 )
 
 // NewQuerier creates a new querier for naeservice clients
@@ -27,6 +42,32 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryWhoIs(ctx, path[1:], req, k)
 		case QueryNames:
 			return queryNames(ctx, req, k)
+		case QueryRecords:
+			return queryRecords(ctx, path[1:], req, k)
+		case QueryAddress:
+			return queryAddress(ctx, path[1:], req, k)
+		case QueryOwned:
+			return queryOwned(ctx, path[1:], req, k)
+		case QueryOwnedBy:
+			return queryOwnedBy(ctx, path[1:], req, k)
+		case QueryOperators:
+			return queryOperators(ctx, path[1:], req, k)
+		case QueryFeeAllowances:
+			return queryFeeAllowances(ctx, path[1:], req, k)
+		case QueryProposals:
+			return queryProposals(ctx, path[1:], req, k)
+		case QueryGuardians:
+			return queryGuardians(ctx, path[1:], req, k)
+		case QueryRecoveries:
+			return queryRecoveries(ctx, path[1:], req, k)
+		case QueryLeases:
+			return queryLeases(ctx, path[1:], req, k)
+		case QueryParams:
+			return queryParams(ctx, req, k)
+		case QueryAuction:
+			return queryAuction(ctx, path[1:], req, k)
+		case QueryStatus:
+			return queryStatus(ctx, path[1:], req, k)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown nameservice query endpoint")
 		}
@@ -36,6 +77,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 // Defining Input Parameters & Responses For Each Query
 
 func queryResolve(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
 	value := keeper.GetName(ctx, path[0])
 
 	if len(value) == 0 {
@@ -79,4 +124,311 @@ func queryNames(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 	}
 
 	return res, nil
-}
\ No newline at end of file
+}
+
+func queryRecords(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
+	records := types.QueryResRecords(keeper.GetRecords(ctx, path[0]))
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, records)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAddress(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
+	// Same Account A MsgSendToName Would Pay
+	address := keeper.GetPaymentAddress(ctx, path[0])
+	if address.Empty() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s has no owner", path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResAddress{Address: address})
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryOwned(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	namesList := types.QueryResNames{}
+
+	// Names Aren't Indexed By Owner, So Every Name Is Visited
+	iterator := keeper.GetNamesIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var whois types.WhoIs
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &whois)
+
+		if whois.IsOwner(owner) {
+			namesList = append(namesList, string(iterator.Key()))
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, namesList)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// Names Owned By Each Of A Comma Separated List Of Owners, In A Single Pass Over The Store
+func queryOwnedBy(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var owners []string
+	index := make(map[string]int)
+
+	for _, owner := range strings.Split(path[0], ",") {
+		addr, err := sdk.AccAddressFromBech32(owner)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+
+		if _, ok := index[addr.String()]; !ok {
+			index[addr.String()] = len(owners)
+			owners = append(owners, addr.String())
+		}
+	}
+
+	ownedBy := make(types.QueryResOwnedBy, len(owners))
+	for i, owner := range owners {
+		addr, _ := sdk.AccAddressFromBech32(owner)
+		ownedBy[i] = types.OwnedNames{Owner: addr, Names: []string{}}
+	}
+
+	iterator := keeper.GetNamesIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var whois types.WhoIs
+		keeper.cdc.MustUnmarshalBinaryBare(iterator.Value(), &whois)
+
+		for _, owner := range whois.AllOwners() {
+			if i, ok := index[owner.String()]; ok {
+				ownedBy[i].Names = append(ownedBy[i].Names, string(iterator.Key()))
+			}
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, ownedBy)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryOperators(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
+	operators := types.QueryResOperators(keeper.GetOperators(ctx, path[0]))
+	if operators == nil {
+		operators = types.QueryResOperators{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, operators)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryFeeAllowances(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	grantee, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	allowances := types.QueryResFeeAllowances(keeper.GetFeeAllowances(ctx, grantee))
+	if allowances == nil {
+		allowances = types.QueryResFeeAllowances{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, allowances)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
+	proposals := types.QueryResProposals(keeper.GetProposals(ctx, path[0]))
+	if proposals == nil {
+		proposals = types.QueryResProposals{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, proposals)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryGuardians(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
+	guardians, found := keeper.GetGuardians(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s has no guardians", path[0])
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, guardians)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryRecoveries(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
+	recoveries := types.QueryResRecoveries(keeper.GetRecoveries(ctx, path[0]))
+	if recoveries == nil {
+		recoveries = types.QueryResRecoveries{}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, recoveries)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryLeases(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !keeper.IsNamePresent(ctx, path[0]) {
+		return nil, sdkerrors.Wrap(types.ErrNameDoesNotExist, path[0])
+	}
+
+	// The Chain Of Active Leases, Followed By The Offers Outstanding
+	leases := types.QueryResLeases(keeper.GetLeaseChain(ctx, path[0]))
+	if leases == nil {
+		leases = types.QueryResLeases{}
+	}
+
+	for _, lease := range keeper.GetLeases(ctx, path[0]) {
+		if !lease.Accepted() {
+			leases = append(leases, lease)
+		}
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, leases)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryAuction(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	auction, found := keeper.GetAuction(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s Isn't Being Auctioned", path[0])
+	}
+
+	// The Price Falls Every Block, So It Is Worked Out At The Height Queried
+	res, err := codec.MarshalJSONIndent(keeper.cdc, types.QueryResAuction{Auction: auction, Price: auction.Price(ctx.BlockHeight())})
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryStatus(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	name := path[0]
+	if err := types.ValidateName(name); err != nil {
+		return nil, err
+	}
+
+	// Unowned Names Go For The Minimum Price Unless Auctioned Or Cooling Down
+	status := types.QueryResStatus{Name: name, Status: types.StatusAvailable, Price: keeper.GetPrice(ctx, name)}
+
+	auction, auctioned := keeper.GetAuction(ctx, name)
+	cooldown, coolingDown := keeper.GetCooldown(ctx, name)
+
+	switch {
+	case keeper.HasOwner(ctx, name):
+		whois := keeper.GetWhoIs(ctx, name)
+		status.Status = types.StatusRegistered
+		status.WhoIs = &whois
+
+		if whois.SelfAssessed {
+			status.TaxOwed = keeper.GetTaxOwed(ctx, name)
+		}
+
+		if chain := keeper.GetLeaseChain(ctx, name); len(chain) != 0 {
+			status.Status = types.StatusLeased
+			status.Leases = chain
+		}
+	case auctioned:
+		status.Status = types.StatusAuction
+		if auction.Bidding() {
+			status.Status = types.StatusBidding
+		}
+		status.Price = auction.Price(ctx.BlockHeight())
+		status.Auction = &auction
+	case coolingDown:
+		status.Status = types.StatusCooldown
+		status.Cooldown = &cooldown
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.cdc, status)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Guardian Set Getters & Setters
+
+func (k Keeper) GetGuardianSet(ctx sdk.Context, owner sdk.AccAddress, name string) (types.GuardianSet, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GuardianSetKey(owner, name))
+	if bz == nil {
+		return types.GuardianSet{}, false
+	}
+
+	var set types.GuardianSet
+	k.cdc.MustUnmarshalBinaryBare(bz, &set)
+	return set, true
+}
+
+func (k Keeper) SetGuardianSet(ctx sdk.Context, set types.GuardianSet) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GuardianSetKey(set.Owner, set.Name), k.cdc.MustMarshalBinaryBare(set))
+}
+
+func (k Keeper) DeleteGuardianSet(ctx sdk.Context, owner sdk.AccAddress, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GuardianSetKey(owner, name))
+}
+
+// GetGuardians returns the guardian set covering name: the one its owner gave
+// it, else the one on all the owner's names. Names with several owners have
+// none.
+func (k Keeper) GetGuardians(ctx sdk.Context, name string) (types.GuardianSet, bool) {
+	whois := k.GetWhoIs(ctx, name)
+	if whois.Owner.Empty() || whois.IsMultiOwner() {
+		return types.GuardianSet{}, false
+	}
+
+	if set, found := k.GetGuardianSet(ctx, whois.Owner, name); found {
+		return set, true
+	}
+	return k.GetGuardianSet(ctx, whois.Owner, "")
+}
+
+// GetAllGuardianSets returns every guardian set in the store
+func (k Keeper) GetAllGuardianSets(ctx sdk.Context) []types.GuardianSet {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GuardianSetKeyPrefix)
+	defer iterator.Close()
+
+	var sets []types.GuardianSet
+	for ; iterator.Valid(); iterator.Next() {
+		var set types.GuardianSet
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &set)
+		sets = append(sets, set)
+	}
+	return sets
+}
+
+// Recovery Getters & Setters
+
+func (k Keeper) GetRecovery(ctx sdk.Context, name string, newOwner sdk.AccAddress) (types.Recovery, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.RecoveryKey(name, newOwner))
+	if bz == nil {
+		return types.Recovery{}, false
+	}
+
+	var recovery types.Recovery
+	k.cdc.MustUnmarshalBinaryBare(bz, &recovery)
+	return recovery, true
+}
+
+func (k Keeper) SetRecovery(ctx sdk.Context, recovery types.Recovery) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecoveryKey(recovery.Name, recovery.NewOwner), k.cdc.MustMarshalBinaryBare(recovery))
+}
+
+func (k Keeper) DeleteRecovery(ctx sdk.Context, name string, newOwner sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RecoveryKey(name, newOwner))
+}
+
+// DeleteRecoveries removes every recovery of name
+func (k Keeper) DeleteRecoveries(ctx sdk.Context, name string) {
+	for _, recovery := range k.iterateRecoveries(ctx, types.RecoveriesKey(name)) {
+		k.DeleteRecovery(ctx, name, recovery.NewOwner)
+	}
+}
+
+// PruneRecoveries removes the recoveries of name that didn't gather enough
+// approvals in time
+func (k Keeper) PruneRecoveries(ctx sdk.Context, name string) {
+	for _, recovery := range k.iterateRecoveries(ctx, types.RecoveriesKey(name)) {
+		if recovery.Expired(ctx.BlockTime()) {
+			k.DeleteRecovery(ctx, name, recovery.NewOwner)
+		}
+	}
+}
+
+// GetRecoveries returns the unexpired recoveries of name
+func (k Keeper) GetRecoveries(ctx sdk.Context, name string) []types.Recovery {
+	var recoveries []types.Recovery
+	for _, recovery := range k.iterateRecoveries(ctx, types.RecoveriesKey(name)) {
+		if !recovery.Expired(ctx.BlockTime()) {
+			recoveries = append(recoveries, recovery)
+		}
+	}
+	return recoveries
+}
+
+// GetAllRecoveries returns every recovery in the store, expired or not
+func (k Keeper) GetAllRecoveries(ctx sdk.Context) []types.Recovery {
+	return k.iterateRecoveries(ctx, types.RecoveryKeyPrefix)
+}
+
+func (k Keeper) iterateRecoveries(ctx sdk.Context, prefix []byte) []types.Recovery {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var recoveries []types.Recovery
+	for ; iterator.Valid(); iterator.Next() {
+		var recovery types.Recovery
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &recovery)
+		recoveries = append(recoveries, recovery)
+	}
+	return recoveries
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Harberger Tax Getters & Setters
+
+// SetTaxPaidThrough records name's tax as paid up to height, queueing the
+// name to be settled again a tax period later
+func (k Keeper) SetTaxPaidThrough(ctx sdk.Context, name string, height int64) {
+	k.UnqueueTax(ctx, name)
+
+	whois := k.GetWhoIs(ctx, name)
+	whois.TaxPaidThrough = height
+	k.SetWhoIs(ctx, name, whois)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TaxQueueKey(height, name), []byte{})
+}
+
+// UnqueueTax takes name off the tax queue, as it leaves Harberger tax
+func (k Keeper) UnqueueTax(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TaxQueueKey(k.GetWhoIs(ctx, name).TaxPaidThrough, name))
+}
+
+// DequeueTaxes takes the names whose tax was last paid through height or
+// earlier off the queue, in the order they fell due
+func (k Keeper) DequeueTaxes(ctx sdk.Context, height int64) []string {
+	// Nothing Falls Due Within The First Tax Period, And A Negative Height Would Key Past The Whole Queue
+	if height < 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.TaxQueueKeyPrefix, types.TaxQueueHeightKey(height+1))
+	defer iterator.Close()
+
+	var keys [][]byte
+	var names []string
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		names = append(names, string(iterator.Key()[len(types.TaxQueueKeyPrefix)+8:]))
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return names
+}
+
+// GetTaxOwed returns the tax name owes since it was last paid through
+func (k Keeper) GetTaxOwed(ctx sdk.Context, name string) sdk.Coins {
+	whois := k.GetWhoIs(ctx, name)
+	if !whois.SelfAssessed {
+		return sdk.Coins{}
+	}
+
+	params := k.GetParams(ctx)
+	return types.Tax(whois.Price, params.TaxRate, ctx.BlockHeight()-whois.TaxPaidThrough, params.TaxPeriod)
+}
+
+// FundCommunityPool pays amount, held by the module account, into the
+// community pool
+func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins) error {
+	return k.DistrKeeper.FundCommunityPool(ctx, amount, k.SupplyKeeper.GetModuleAddress(types.ModuleName))
+}
@@ -0,0 +1,127 @@
+package keeper
+
+// DONTCOVER
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/arjunandra/nameservice-cosmos/x/nameservice/internal/types"
+)
+
+// Module Account Standing In For The Distribution Module's Community Pool
+const CommunityPoolName = "distribution"
+
+// TestInput is a nameservice keeper over an in-memory store, along with the
+// keepers it runs on
+type TestInput struct {
+	Ctx           sdk.Context
+	Cdc           *codec.Codec
+	Keeper        Keeper
+	AccountKeeper auth.AccountKeeper
+	BankKeeper    bank.Keeper
+	SupplyKeeper  supply.Keeper
+	CommunityPool CommunityPool
+}
+
+// CommunityPool is a DistrKeeper paying into the CommunityPoolName module
+// account, so tests can read what the pool was funded with
+type CommunityPool struct {
+	supplyKeeper supply.Keeper
+}
+
+var _ types.DistrKeeper = CommunityPool{}
+
+// FundCommunityPool implements types.DistrKeeper
+func (p CommunityPool) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	return p.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, CommunityPoolName, amount)
+}
+
+// Balance returns everything paid into the pool
+func (p CommunityPool) Balance(ctx sdk.Context) sdk.Coins {
+	return p.supplyKeeper.GetModuleAccount(ctx, CommunityPoolName).GetCoins()
+}
+
+// CreateTestInput builds a keeper with the default params at height 1
+func CreateTestInput() TestInput {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyNameservice := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyNameservice, sdk.StoreTypeIAVL, db)
+	if err := ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "nameservice", Height: 1, Time: time.Unix(0, 0).UTC()}, false, log.NewNopLogger())
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), nil)
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, map[string][]string{
+		auth.FeeCollectorName: nil,
+		CommunityPoolName:     nil,
+		types.ModuleName:      nil,
+	})
+
+	accountKeeper.SetParams(ctx, auth.DefaultParams())
+	bankKeeper.SetSendEnabled(ctx, true)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins()))
+
+	pool := CommunityPool{supplyKeeper: supplyKeeper}
+
+	keeper := NewKeeper(accountKeeper, bankKeeper, supplyKeeper, pool, keyNameservice, cdc, pk.Subspace(types.DefaultParamspace))
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	return TestInput{
+		Ctx:           ctx,
+		Cdc:           cdc,
+		Keeper:        keeper,
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		SupplyKeeper:  supplyKeeper,
+		CommunityPool: pool,
+	}
+}
+
+// NewAccount creates an account holding coins, its address derived from seed
+func (in TestInput) NewAccount(seed string, coins sdk.Coins) sdk.AccAddress {
+	addr := sdk.AccAddress(crypto.AddressHash([]byte(seed)))
+	if err := in.BankKeeper.SetCoins(in.Ctx, addr, coins); err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// ModuleBalance returns what the nameservice module account holds
+func (in TestInput) ModuleBalance() sdk.Coins {
+	return in.SupplyKeeper.GetModuleAccount(in.Ctx, types.ModuleName).GetCoins()
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance is coins Granter set aside, held by the module account, to pay
+// the fees of Grantee's nameservice txs
+type FeeAllowance struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Remaining sdk.Coins      `json:"remaining"`
+
+	// The Most The Fee Of Any One Tx Can Take, So One Tx Can't Drain The
+	// Allowance. Empty Caps Nothing.
+	SpendLimit sdk.Coins `json:"spend_limit"`
+
+	// The Zero Time Never Expires
+	Expiry time.Time `json:"expiry"`
+}
+
+// Covers reports whether the allowance can still pay fee at now
+func (a FeeAllowance) Covers(fee sdk.Coins, now time.Time) bool {
+	if !a.Expiry.IsZero() && !now.Before(a.Expiry) {
+		return false
+	}
+	if !a.SpendLimit.Empty() && !a.SpendLimit.IsAllGTE(fee) {
+		return false
+	}
+	return a.Remaining.IsAllGTE(fee)
+}
+
+func (a FeeAllowance) String() string {
+	expiry := "never"
+	if !a.Expiry.IsZero() {
+		expiry = a.Expiry.UTC().Format(time.RFC3339)
+	}
+
+	spendLimit := "none"
+	if !a.SpendLimit.Empty() {
+		spendLimit = a.SpendLimit.String()
+	}
+
+	return fmt.Sprintf("%s\t%s\t%s\t%s", a.Granter, a.Remaining, spendLimit, expiry)
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Auction sells Name, released back into circulation, at a price falling
+// block by block from StartPrice at height Start to the minimum name price
+// at End. When params open a bidding window, the first buy at that price is
+// only the leading Bid, and the name goes to whoever leads when bidding
+// closes at height Close, which bids late in the window push back.
+type Auction struct {
+	Name       string    `json:"name"`
+	StartPrice sdk.Coins `json:"start_price"`
+	Start      int64     `json:"start"`
+	End        int64     `json:"end"`
+
+	// Unset Until The First Bid Opens Bidding
+	Bidder   sdk.AccAddress `json:"bidder,omitempty"`
+	Bid      sdk.Coins      `json:"bid,omitempty"`
+	Close    int64          `json:"close,omitempty"`
+	Extended int64          `json:"extended,omitempty"`
+}
+
+// NewAuction returns the auction of name, last priced at lastPrice, starting
+// at multiple times that price and running for blocks blocks from height
+func NewAuction(name string, lastPrice sdk.Coins, multiple sdk.Dec, height, blocks int64) Auction {
+	startPrice, _ := sdk.NewDecCoinsFromCoins(lastPrice...).MulDec(multiple).TruncateDecimal()
+
+	// The Price Never Falls Below The Minimum, So It Can't Start There Either
+	if !startPrice.IsAllGTE(minNamePrice) {
+		startPrice = startPrice.Add(minNamePrice...)
+	}
+
+	return Auction{
+		Name:       name,
+		StartPrice: startPrice,
+		Start:      height,
+		End:        height + blocks,
+	}
+}
+
+// Bidding reports whether a bid has stopped the price falling
+func (a Auction) Bidding() bool {
+	return !a.Bidder.Empty()
+}
+
+// Closes returns the height the auction ends at: its close once bidding
+// opens, else the end of its falling price
+func (a Auction) Closes() int64 {
+	if a.Bidding() {
+		return a.Close
+	}
+	return a.End
+}
+
+// Price returns what the name sells for at height: the leading bid once
+// bidding opens, else each coin of the start price falling linearly to the
+// minimum name price over the auction
+func (a Auction) Price(height int64) sdk.Coins {
+	if a.Bidding() {
+		return a.Bid
+	}
+
+	if height >= a.End {
+		return minNamePrice
+	}
+
+	if height < a.Start {
+		height = a.Start
+	}
+
+	var premium sdk.Coins
+	for _, coin := range a.StartPrice.Sub(minNamePrice) {
+		amount := coin.Amount.MulRaw(a.End - height).QuoRaw(a.End - a.Start)
+		premium = append(premium, sdk.NewCoin(coin.Denom, amount))
+	}
+	return minNamePrice.Add(sdk.NewCoins(premium...)...)
+}
+
+// Validate checks the auction can run
+func (a Auction) Validate() error {
+	if a.End <= a.Start {
+		return fmt.Errorf("auction of %s must end after it starts", a.Name)
+	}
+
+	if !a.StartPrice.IsValid() || !a.StartPrice.IsAllGTE(minNamePrice) {
+		return fmt.Errorf("auction of %s must start at %s or more, not %s", a.Name, minNamePrice, a.StartPrice)
+	}
+
+	if a.Bidding() && (!a.Bid.IsValid() || a.Bid.IsZero() || a.Close < a.Start || a.Extended < 0) {
+		return fmt.Errorf("auction of %s has an invalid bid %s closing at %d", a.Name, a.Bid, a.Close)
+	}
+	return nil
+}
+
+func (a Auction) String() string {
+	if a.Bidding() {
+		return fmt.Sprintf("%s\t%s\tblocks %d-%d\t%s bid by %s, closing at %d (extended %d)", a.Name, a.StartPrice, a.Start, a.End, a.Bid, a.Bidder, a.Close, a.Extended)
+	}
+	return fmt.Sprintf("%s\t%s\tblocks %d-%d", a.Name, a.StartPrice, a.Start, a.End)
+}
@@ -10,6 +10,27 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSetName{}, "nameservice/SetName", nil)
 	cdc.RegisterConcrete(MsgBuyName{}, "nameservice/BuyName", nil)
 	cdc.RegisterConcrete(MsgDeleteName{}, "nameservice/DeleteName", nil)
+	cdc.RegisterConcrete(MsgSetRecords{}, "nameservice/SetRecords", nil)
+	cdc.RegisterConcrete(MsgSendToName{}, "nameservice/SendToName", nil)
+	cdc.RegisterConcrete(MsgBatch{}, "nameservice/Batch", nil)
+	cdc.RegisterConcrete(MsgApproveOperator{}, "nameservice/ApproveOperator", nil)
+	cdc.RegisterConcrete(MsgRevokeOperator{}, "nameservice/RevokeOperator", nil)
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "nameservice/GrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "nameservice/RevokeFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgSetOwners{}, "nameservice/SetOwners", nil)
+	cdc.RegisterConcrete(MsgPropose{}, "nameservice/Propose", nil)
+	cdc.RegisterConcrete(MsgApproveProposal{}, "nameservice/ApproveProposal", nil)
+	cdc.RegisterConcrete(MsgSetGuardians{}, "nameservice/SetGuardians", nil)
+	cdc.RegisterConcrete(MsgStartRecovery{}, "nameservice/StartRecovery", nil)
+	cdc.RegisterConcrete(MsgApproveRecovery{}, "nameservice/ApproveRecovery", nil)
+	cdc.RegisterConcrete(MsgVetoRecovery{}, "nameservice/VetoRecovery", nil)
+	cdc.RegisterConcrete(MsgFinalizeRecovery{}, "nameservice/FinalizeRecovery", nil)
+	cdc.RegisterConcrete(MsgOfferLease{}, "nameservice/OfferLease", nil)
+	cdc.RegisterConcrete(MsgAcceptLease{}, "nameservice/AcceptLease", nil)
+	cdc.RegisterConcrete(MsgEndLease{}, "nameservice/EndLease", nil)
+	cdc.RegisterConcrete(MsgSelfAssess{}, "nameservice/SelfAssess", nil)
+	cdc.RegisterConcrete(MsgDepositTax{}, "nameservice/DepositTax", nil)
+	cdc.RegisterConcrete(MsgWithdrawTax{}, "nameservice/WithdrawTax", nil)
 }
 
 // ModuleCdc defines the module codec
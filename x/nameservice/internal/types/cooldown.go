@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Cooldown keeps Name, deleted by Owners, for them alone to register again
+// up to and including block Until
+type Cooldown struct {
+	Name   string           `json:"name"`
+	Owners []sdk.AccAddress `json:"owners"`
+	Until  int64            `json:"until"`
+}
+
+// Validate checks the cooldown holds the name for someone
+func (c Cooldown) Validate() error {
+	if len(c.Owners) == 0 {
+		return fmt.Errorf("cooldown of %s must hold it for its last owners", c.Name)
+	}
+
+	for _, owner := range c.Owners {
+		if owner.Empty() {
+			return fmt.Errorf("cooldown of %s has an empty owner", c.Name)
+		}
+	}
+	return nil
+}
+
+func (c Cooldown) String() string {
+	return fmt.Sprintf("%s\theld for %d last owners until block %d", c.Name, len(c.Owners), c.Until)
+}
@@ -12,4 +12,6 @@ import (
 
 var (
 	ErrNameDoesNotExist = sdkerrors.Register(ModuleName, 1, "Name Doesn't Exist")
+	ErrInvalidRecord	= sdkerrors.Register(ModuleName, 2, "Invalid Record")
+	ErrInvalidName		= sdkerrors.Register(ModuleName, 3, "Invalid Name")
 )
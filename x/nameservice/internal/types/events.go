@@ -2,14 +2,83 @@ package types
 
 // nameservice module event types
 const (
-	// TODO: Create your event types
-	// EventType<Action>    		= "action"
+	EventTypeRegisterName	= "register_name"
+	EventTypeBuyName		= "buy_name"
+	EventTypeSetName		= "set_name"
+	EventTypeDeleteName		= "delete_name"
+	EventTypeSetRecords		= "set_records"
+	EventTypeSendToName		= "send_to_name"
+	EventTypeBatchOperation	= "batch_operation"
+	EventTypeApproveOperator	= "approve_operator"
+	EventTypeRevokeOperator	= "revoke_operator"
+	EventTypeGrantFeeAllowance	= "grant_fee_allowance"
+	EventTypeRevokeFeeAllowance	= "revoke_fee_allowance"
+	EventTypeSetOwners		= "set_owners"
+	EventTypePropose		= "propose"
+	EventTypeApproveProposal	= "approve_proposal"
+	EventTypeExecuteProposal	= "execute_proposal"
+	EventTypeSetGuardians	= "set_guardians"
+	EventTypeStartRecovery	= "start_recovery"
+	EventTypeApproveRecovery	= "approve_recovery"
+	EventTypeRecoveryApproved	= "recovery_approved"
+	EventTypeVetoRecovery	= "veto_recovery"
+	EventTypeRecoverName	= "recover_name"
+	EventTypeOfferLease		= "offer_lease"
+	EventTypeAcceptLease	= "accept_lease"
+	EventTypePayLease		= "pay_lease"
+	EventTypeEndLease		= "end_lease"
+	EventTypeSelfAssess		= "self_assess"
+	EventTypeDepositTax		= "deposit_tax"
+	EventTypeWithdrawTax	= "withdraw_tax"
+	EventTypePayTax			= "pay_tax"
+	EventTypeForecloseName	= "foreclose_name"
+	EventTypeStartAuction	= "start_auction"
+	EventTypeEndAuction		= "end_auction"
+	EventTypeBidAuction		= "bid_auction"
+	EventTypeExtendAuction	= "extend_auction"
+	EventTypeEndCooldown	= "end_cooldown"
 
-	// TODO: Create keys fo your events, the values will be derivided from the msg
-	// AttributeKeyAddress  		= "address"
-
-	// TODO: Some events may not have values for that reason you want to emit that something happened.
-	// AttributeValueDoubleSign = "double_sign"
+	AttributeKeyName		= "name"
+	AttributeKeyValue		= "value"
+	AttributeKeyOwner		= "owner"
+	AttributeKeyPrice		= "price"
+	AttributeKeyPreviousOwner = "previous_owner"
+	AttributeKeyRecords		= "records"
+	AttributeKeyRecipient	= "recipient"
+	AttributeKeyAmount		= "amount"
+	AttributeKeyIndex		= "index"
+	AttributeKeyOperation	= "operation"
+	AttributeKeyGasUsed		= "gas_used"
+	AttributeKeyOperator	= "operator"
+	AttributeKeyScope		= "scope"
+	AttributeKeyExpiry		= "expiry"
+	AttributeKeyGranter		= "granter"
+	AttributeKeyGrantee		= "grantee"
+	AttributeKeyRemaining	= "remaining"
+	AttributeKeySpendLimit	= "spend_limit"
+	AttributeKeyOwners		= "owners"
+	AttributeKeyThreshold	= "threshold"
+	AttributeKeyProposalID	= "proposal_id"
+	AttributeKeyAction		= "action"
+	AttributeKeyApprovals	= "approvals"
+	AttributeKeyGuardians	= "guardians"
+	AttributeKeyGuardian	= "guardian"
+	AttributeKeyNewOwner	= "new_owner"
+	AttributeKeyDelay		= "delay"
+	AttributeKeyReadyAt		= "ready_at"
+	AttributeKeyLessor		= "lessor"
+	AttributeKeyLessee		= "lessee"
+	AttributeKeyFee			= "fee"
+	AttributeKeyPeriod		= "period"
+	AttributeKeyEndHeight	= "end_height"
+	AttributeKeyRefund		= "refund"
+	AttributeKeyDeposit		= "deposit"
+	AttributeKeyTax			= "tax"
+	AttributeKeyStartPrice	= "start_price"
+	AttributeKeyPaid		= "paid"
+	AttributeKeyBidder		= "bidder"
+	AttributeKeyBid			= "bid"
+	AttributeKeyExtended	= "extended"
 
 	AttributeValueCategory = ModuleName
 )
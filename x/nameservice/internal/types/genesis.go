@@ -2,33 +2,102 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - all nameservice state that must be provided at genesis
 type GenesisState struct {
-	whoIsRecords []WhoIs	`json:"whois_records"`
+	WhoIsRecords []GenesisWhoIs	`json:"whois_records"`
+	Operators []OperatorApproval	`json:"operators,omitempty"`
+	FeeAllowances []FeeAllowance	`json:"fee_allowances,omitempty"`
+	Proposals []Proposal			`json:"proposals,omitempty"`
+	NextProposalID uint64			`json:"next_proposal_id,omitempty"`
+	GuardianSets []GuardianSet		`json:"guardian_sets,omitempty"`
+	Recoveries []Recovery			`json:"recoveries,omitempty"`
+	Leases []Lease					`json:"leases,omitempty"`
+	Auctions []Auction				`json:"auctions,omitempty"`
+	Cooldowns []Cooldown			`json:"cooldowns,omitempty"`
+	Params Params					`json:"params"`
 }
 
+// GenesisWhoIs pairs a whoIs with the name it is stored under
+type GenesisWhoIs struct {
+	Name string		`json:"name"`
+	WhoIs WhoIs		`json:"whois"`
+}
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState( /* TODO: Fill out with what is needed for genesis state */) GenesisState {
+func NewGenesisState(whoIsRecords []GenesisWhoIs, operators []OperatorApproval, feeAllowances []FeeAllowance, proposals []Proposal, nextProposalID uint64, guardianSets []GuardianSet, recoveries []Recovery, leases []Lease, auctions []Auction, cooldowns []Cooldown, params Params) GenesisState {
 	return GenesisState{
-		whoIsRecords: nil,
+		WhoIsRecords: whoIsRecords,
+		Operators: operators,
+		FeeAllowances: feeAllowances,
+		Proposals: proposals,
+		NextProposalID: nextProposalID,
+		GuardianSets: guardianSets,
+		Recoveries: recoveries,
+		Leases: leases,
+		Auctions: auctions,
+		Cooldowns: cooldowns,
+		Params: params,
 	}
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		whoIsRecords: []WhoIs{},
+		WhoIsRecords: []GenesisWhoIs{},
+		Params: DefaultParams(),
 	}
 }
 
+// HeldCoins returns what the module account must hold for genState: tax
+// deposits, escrowed prices, lease escrows, fee allowances and winning bids
+func (genState GenesisState) HeldCoins() sdk.Coins {
+	held := sdk.NewCoins()
+
+	for _, record := range genState.WhoIsRecords {
+		held = held.Add(record.WhoIs.Deposit...).Add(record.WhoIs.Escrow...)
+	}
+
+	for _, lease := range genState.Leases {
+		held = held.Add(lease.Escrow...)
+	}
+
+	for _, allowance := range genState.FeeAllowances {
+		held = held.Add(allowance.Remaining...)
+	}
+
+	for _, auction := range genState.Auctions {
+		if auction.Bidding() {
+			held = held.Add(auction.Bid...)
+		}
+	}
+
+	return held
+}
+
 // ValidateGenesis validates the nameservice genesis parameters
 func ValidateGenesis(genState GenesisState) error {
+	if err := genState.Params.Validate(); err != nil {
+		return fmt.Errorf("Invalid params: %w", err)
+	}
+
+	seen := make(map[string]bool)
 
 	// Fetch & Iterate Through Names' whoIs
-	for _, whoIs := range genState.whoIsRecords {
+	for _, record := range genState.WhoIsRecords {
+		whoIs := record.WhoIs
+
+		if err := ValidateName(record.Name); err != nil {
+			return fmt.Errorf("Invalid whoIsRecord: %s (Value) - %w", whoIs.Value, err)
+		}
+
+		if seen[record.Name] {
+			return fmt.Errorf("Invalid whoIsRecord: %s (Name) - Duplicate Name", record.Name)
+		}
+		seen[record.Name] = true
 
 		if whoIs.Owner == nil {
 			return fmt.Errorf("Invalid whoIsRecord: %s (Value) - Missing Owner", whoIs.Value)
@@ -41,7 +110,223 @@ func ValidateGenesis(genState GenesisState) error {
 		if whoIs.Price == nil {
 			return fmt.Errorf("Invalid whoIsRecord: %s (Value) - Missing Price", whoIs.Value)
 		}
+
+		for _, r := range whoIs.Records {
+			if err := r.Validate(); err != nil {
+				return fmt.Errorf("Invalid whoIsRecord: %s (Name) - %w", record.Name, err)
+			}
+		}
+
+		if whoIs.IsMultiOwner() {
+			if err := ValidateOwners(whoIs.Owners, whoIs.Threshold); err != nil {
+				return fmt.Errorf("Invalid whoIsRecord: %s (Name) - %w", record.Name, err)
+			}
+
+			if len(whoIs.Owners) == 1 || !whoIs.Owners[0].Equals(whoIs.Owner) {
+				return fmt.Errorf("Invalid whoIsRecord: %s (Name) - Owner Must Be The First Of Several Owners", record.Name)
+			}
+		}
+
+		if whoIs.SelfAssessed && whoIs.IsMultiOwner() {
+			return fmt.Errorf("Invalid whoIsRecord: %s (Name) - Harberger Names Have One Owner", record.Name)
+		}
+
+		if !whoIs.Deposit.IsValid() {
+			return fmt.Errorf("Invalid whoIsRecord: %s (Name) - Invalid Deposit %s", record.Name, whoIs.Deposit)
+		}
+
+		if !whoIs.Escrow.IsValid() {
+			return fmt.Errorf("Invalid whoIsRecord: %s (Name) - Invalid Escrow %s", record.Name, whoIs.Escrow)
+		}
+	}
+
+	seenOperators := make(map[string]bool)
+
+	for _, approval := range genState.Operators {
+		if approval.Owner.Empty() || approval.Operator.Empty() {
+			return fmt.Errorf("Invalid operator: %s (Name) - Missing Owner Or Operator", approval.Name)
+		}
+
+		if approval.Name != "" {
+			if err := ValidateName(approval.Name); err != nil {
+				return fmt.Errorf("Invalid operator: %s (Operator) - %w", approval.Operator, err)
+			}
+		}
+
+		if err := ValidateScope(approval.Scope); err != nil {
+			return fmt.Errorf("Invalid operator: %s (Operator) - %w", approval.Operator, err)
+		}
+
+		key := string(OperatorKey(approval.Owner, approval.Name, approval.Operator))
+		if seenOperators[key] {
+			return fmt.Errorf("Invalid operator: %s (Operator) - Duplicate Approval On %q", approval.Operator, approval.Name)
+		}
+		seenOperators[key] = true
+	}
+
+	seenAllowances := make(map[string]bool)
+
+	// The Module Account's Balance, Held In The Supply Genesis, Must Cover These
+	for _, allowance := range genState.FeeAllowances {
+		if allowance.Granter.Empty() || allowance.Grantee.Empty() {
+			return fmt.Errorf("Invalid fee allowance: %s (Remaining) - Missing Granter Or Grantee", allowance.Remaining)
+		}
+
+		if !allowance.Remaining.IsValid() {
+			return fmt.Errorf("Invalid fee allowance: %s (Grantee) - Invalid Remaining %s", allowance.Grantee, allowance.Remaining)
+		}
+
+		if !allowance.SpendLimit.IsValid() {
+			return fmt.Errorf("Invalid fee allowance: %s (Grantee) - Invalid Spend Limit %s", allowance.Grantee, allowance.SpendLimit)
+		}
+
+		key := string(FeeAllowanceKey(allowance.Grantee, allowance.Granter))
+		if seenAllowances[key] {
+			return fmt.Errorf("Invalid fee allowance: %s (Grantee) - Duplicate Allowance From %s", allowance.Grantee, allowance.Granter)
+		}
+		seenAllowances[key] = true
+	}
+
+	seenProposals := make(map[uint64]bool)
+
+	for _, proposal := range genState.Proposals {
+		if !seen[proposal.Name] {
+			return fmt.Errorf("Invalid proposal: %d (ID) - Unknown Name %q", proposal.ID, proposal.Name)
+		}
+
+		if proposal.ID == 0 || seenProposals[proposal.ID] {
+			return fmt.Errorf("Invalid proposal: %d (ID) - Zero Or Duplicate ID", proposal.ID)
+		}
+		seenProposals[proposal.ID] = true
+
+		// Proposals Made After Genesis Mustn't Reuse Their IDs
+		if proposal.ID >= genState.NextProposalID {
+			return fmt.Errorf("Invalid proposal: %d (ID) - Not Below The Next Proposal ID %d", proposal.ID, genState.NextProposalID)
+		}
+
+		if err := proposal.Action.Validate(); err != nil {
+			return fmt.Errorf("Invalid proposal: %d (ID) - %w", proposal.ID, err)
+		}
+	}
+
+	seenGuardianSets := make(map[string]bool)
+
+	for _, set := range genState.GuardianSets {
+		if set.Owner.Empty() {
+			return fmt.Errorf("Invalid guardian set: %s (Name) - Missing Owner", set.Name)
+		}
+
+		if set.Name != "" && !seen[set.Name] {
+			return fmt.Errorf("Invalid guardian set: %s (Owner) - Unknown Name %q", set.Owner, set.Name)
+		}
+
+		if err := ValidateGuardians(set.Owner, set.Guardians, set.Threshold, set.Delay); err != nil {
+			return fmt.Errorf("Invalid guardian set: %s (Owner) - %w", set.Owner, err)
+		}
+
+		key := string(GuardianSetKey(set.Owner, set.Name))
+		if seenGuardianSets[key] {
+			return fmt.Errorf("Invalid guardian set: %s (Owner) - Duplicate Set On %q", set.Owner, set.Name)
+		}
+		seenGuardianSets[key] = true
+	}
+
+	seenRecoveries := make(map[string]bool)
+
+	for _, recovery := range genState.Recoveries {
+		if !seen[recovery.Name] {
+			return fmt.Errorf("Invalid recovery: %s (New Owner) - Unknown Name %q", recovery.NewOwner, recovery.Name)
+		}
+
+		if recovery.Owner.Empty() || recovery.NewOwner.Empty() {
+			return fmt.Errorf("Invalid recovery: %s (Name) - Missing Owner Or New Owner", recovery.Name)
+		}
+
+		if recovery.Threshold == 0 || int(recovery.Threshold) > len(recovery.Guardians) {
+			return fmt.Errorf("Invalid recovery: %s (Name) - Threshold Must Be Between 1 And The %d Guardians", recovery.Name, len(recovery.Guardians))
+		}
+
+		key := string(RecoveryKey(recovery.Name, recovery.NewOwner))
+		if seenRecoveries[key] {
+			return fmt.Errorf("Invalid recovery: %s (Name) - Duplicate Recovery To %s", recovery.Name, recovery.NewOwner)
+		}
+		seenRecoveries[key] = true
+	}
+
+	seenLeases := make(map[string]bool)
+
+	// The Module Account's Balance Must Also Cover What Lessees Escrowed
+	for _, lease := range genState.Leases {
+		if !seen[lease.Name] {
+			return fmt.Errorf("Invalid lease: %s (Lessee) - Unknown Name %q", lease.Lessee, lease.Name)
+		}
+
+		if lease.Lessor.Empty() || lease.Lessee.Empty() || lease.Lessor.Equals(lease.Lessee) {
+			return fmt.Errorf("Invalid lease: %s (Name) - Missing Or Identical Lessor And Lessee", lease.Name)
+		}
+
+		if err := ValidateLeaseTerms(lease.Blocks, lease.Fee, lease.Period); err != nil {
+			return fmt.Errorf("Invalid lease: %s (Name) - %w", lease.Name, err)
+		}
+
+		if lease.Accepted() && (lease.End != lease.Start+lease.Blocks || lease.PaidThrough > lease.End) {
+			return fmt.Errorf("Invalid lease: %s (Name) - Inconsistent Start, End And Paid Through Heights", lease.Name)
+		}
+
+		if !lease.Escrow.IsValid() {
+			return fmt.Errorf("Invalid lease: %s (Name) - Invalid Escrow %s", lease.Name, lease.Escrow)
+		}
+
+		key := string(LeaseKey(lease.Name, lease.Lessor))
+		if seenLeases[key] {
+			return fmt.Errorf("Invalid lease: %s (Name) - Duplicate Lease From %s", lease.Name, lease.Lessor)
+		}
+		seenLeases[key] = true
+	}
+
+	seenAuctions := make(map[string]bool)
+
+	// Names Are Only Auctioned While Unowned
+	for _, auction := range genState.Auctions {
+		if err := ValidateName(auction.Name); err != nil {
+			return fmt.Errorf("Invalid auction: %s (Start Price) - %w", auction.StartPrice, err)
+		}
+
+		if seen[auction.Name] {
+			return fmt.Errorf("Invalid auction: %s (Name) - Name Is Owned", auction.Name)
+		}
+
+		if err := auction.Validate(); err != nil {
+			return fmt.Errorf("Invalid auction: %s (Name) - %w", auction.Name, err)
+		}
+
+		if seenAuctions[auction.Name] {
+			return fmt.Errorf("Invalid auction: %s (Name) - Duplicate Auction", auction.Name)
+		}
+		seenAuctions[auction.Name] = true
+	}
+
+	seenCooldowns := make(map[string]bool)
+
+	// Deleted Names Cool Down While Unowned And Unauctioned
+	for _, cooldown := range genState.Cooldowns {
+		if err := ValidateName(cooldown.Name); err != nil {
+			return fmt.Errorf("Invalid cooldown: %d (Until) - %w", cooldown.Until, err)
+		}
+
+		if seen[cooldown.Name] || seenAuctions[cooldown.Name] {
+			return fmt.Errorf("Invalid cooldown: %s (Name) - Name Is Owned Or Auctioned", cooldown.Name)
+		}
+
+		if err := cooldown.Validate(); err != nil {
+			return fmt.Errorf("Invalid cooldown: %s (Name) - %w", cooldown.Name, err)
+		}
+
+		if seenCooldowns[cooldown.Name] {
+			return fmt.Errorf("Invalid cooldown: %s (Name) - Duplicate Cooldown", cooldown.Name)
+		}
+		seenCooldowns[cooldown.Name] = true
 	}
 
 	return nil
-}
\ No newline at end of file
+}
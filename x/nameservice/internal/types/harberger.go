@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tax is what a name declared at price owes for blocks blocks, at rate every
+// period blocks. Fractions of a coin are waived.
+func Tax(price sdk.Coins, rate sdk.Dec, blocks int64, period int64) sdk.Coins {
+	if blocks <= 0 || price.IsZero() {
+		return sdk.Coins{}
+	}
+
+	tax, _ := sdk.NewDecCoinsFromCoins(price...).MulDec(rate.MulInt64(blocks).QuoInt64(period)).TruncateDecimal()
+	return tax
+}
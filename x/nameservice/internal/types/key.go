@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName is the name of the module
 	ModuleName = "nameservice"
@@ -13,3 +19,205 @@ const (
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
 )
+
+// WhoIsKey returns the store key a name's whoIs is kept under
+func WhoIsKey(name string) []byte {
+	return []byte(name)
+}
+
+// Names Start With [a-z0-9], So Their Keys Sort Between These Two And No
+// Other Key In The Store Does
+var (
+	NamesKeyStart = []byte("0")
+	NamesKeyEnd   = []byte("{") // The Byte After 'z'
+)
+
+// Operator Approvals Are Kept Under A Prefix No Name Can Start With
+var OperatorKeyPrefix = []byte{0x01}
+
+// OperatorsKey returns the prefix of the approvals owner gave on name, or on
+// all its names when name is empty
+func OperatorsKey(owner sdk.AccAddress, name string) []byte {
+	key := append([]byte{}, OperatorKeyPrefix...)
+	key = append(key, byte(len(owner)))
+	key = append(key, owner...)
+	key = append(key, name...)
+
+	// Names Hold No Zero Byte, So It Ends The Name Unambiguously
+	return append(key, 0)
+}
+
+// OperatorKey returns the store key of the approval owner gave operator on
+// name, or on all its names when name is empty
+func OperatorKey(owner sdk.AccAddress, name string, operator sdk.AccAddress) []byte {
+	return append(OperatorsKey(owner, name), operator...)
+}
+
+// Fee Allowances Are Kept Under A Prefix No Name Can Start With, Grouped By Grantee
+var FeeAllowanceKeyPrefix = []byte{0x02}
+
+// FeeAllowancesKey returns the prefix of every allowance granted to grantee
+func FeeAllowancesKey(grantee sdk.AccAddress) []byte {
+	key := append([]byte{}, FeeAllowanceKeyPrefix...)
+	key = append(key, byte(len(grantee)))
+	return append(key, grantee...)
+}
+
+// FeeAllowanceKey returns the store key of the allowance granter gave grantee
+func FeeAllowanceKey(grantee, granter sdk.AccAddress) []byte {
+	return append(FeeAllowancesKey(grantee), granter...)
+}
+
+// Proposals Are Kept Under A Prefix No Name Can Start With, Grouped By Name
+var ProposalKeyPrefix = []byte{0x03}
+
+// The Next Proposal ID, Shared By All Names
+var NextProposalIDKey = []byte{0x04}
+
+// ProposalsKey returns the prefix of every proposal on name
+func ProposalsKey(name string) []byte {
+	key := append([]byte{}, ProposalKeyPrefix...)
+	key = append(key, name...)
+	return append(key, 0)
+}
+
+// ProposalKey returns the store key of proposal id on name
+func ProposalKey(name string, id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return append(ProposalsKey(name), bz...)
+}
+
+// Guardian Sets Are Kept Under A Prefix No Name Can Start With, Grouped By Owner
+var GuardianSetKeyPrefix = []byte{0x05}
+
+// GuardianSetKey returns the store key of the guardian set owner gave name,
+// or all its names when name is empty
+func GuardianSetKey(owner sdk.AccAddress, name string) []byte {
+	key := append([]byte{}, GuardianSetKeyPrefix...)
+	key = append(key, byte(len(owner)))
+	key = append(key, owner...)
+	key = append(key, name...)
+	return append(key, 0)
+}
+
+// Recoveries Are Kept Under A Prefix No Name Can Start With, Grouped By Name
+var RecoveryKeyPrefix = []byte{0x06}
+
+// RecoveriesKey returns the prefix of every recovery of name
+func RecoveriesKey(name string) []byte {
+	key := append([]byte{}, RecoveryKeyPrefix...)
+	key = append(key, name...)
+	return append(key, 0)
+}
+
+// RecoveryKey returns the store key of the recovery of name to newOwner
+func RecoveryKey(name string, newOwner sdk.AccAddress) []byte {
+	return append(RecoveriesKey(name), newOwner...)
+}
+
+// Leases Are Kept Under A Prefix No Name Can Start With, Grouped By Name
+var LeaseKeyPrefix = []byte{0x07}
+
+// LeasesKey returns the prefix of every lease of name
+func LeasesKey(name string) []byte {
+	key := append([]byte{}, LeaseKeyPrefix...)
+	key = append(key, name...)
+	return append(key, 0)
+}
+
+// LeaseKey returns the store key of the lease lessor offered or gave on name
+func LeaseKey(name string, lessor sdk.AccAddress) []byte {
+	return append(LeasesKey(name), lessor...)
+}
+
+// Accepted Leases Are Queued By The Height Their Next Payment Or End Is Due
+var LeaseQueueKeyPrefix = []byte{0x08}
+
+// LeaseQueueHeightKey returns the prefix of the leases due at height
+func LeaseQueueHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, LeaseQueueKeyPrefix...), bz...)
+}
+
+// LeaseQueueKey returns the queue key of the lease lessor gave on name, due
+// at height
+func LeaseQueueKey(height int64, name string, lessor sdk.AccAddress) []byte {
+	key := append(LeaseQueueHeightKey(height), name...)
+	key = append(key, 0)
+	return append(key, lessor...)
+}
+
+// SplitLeaseQueueKey returns the name and lessor a queue key was made from
+func SplitLeaseQueueKey(key []byte) (string, sdk.AccAddress) {
+	rest := key[len(LeaseQueueKeyPrefix)+8:]
+	for i, b := range rest {
+		if b == 0 {
+			return string(rest[:i]), sdk.AccAddress(rest[i+1:])
+		}
+	}
+	return string(rest), nil
+}
+
+// Harberger Names Are Queued By The Height Their Tax Was Last Paid Through
+var TaxQueueKeyPrefix = []byte{0x09}
+
+// TaxQueueHeightKey returns the prefix of the names whose tax was paid
+// through height
+func TaxQueueHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, TaxQueueKeyPrefix...), bz...)
+}
+
+// TaxQueueKey returns the queue key of name, its tax paid through height
+func TaxQueueKey(height int64, name string) []byte {
+	return append(TaxQueueHeightKey(height), name...)
+}
+
+// Auctions Are Stored By Name, And Queued By The Height They End
+var (
+	AuctionKeyPrefix      = []byte{0x0a}
+	AuctionQueueKeyPrefix = []byte{0x0b}
+)
+
+// AuctionKey returns the store key of name's auction
+func AuctionKey(name string) []byte {
+	return append(append([]byte{}, AuctionKeyPrefix...), name...)
+}
+
+// AuctionQueueHeightKey returns the prefix of the auctions ending at height
+func AuctionQueueHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, AuctionQueueKeyPrefix...), bz...)
+}
+
+// AuctionQueueKey returns the queue key of name's auction, ending at height
+func AuctionQueueKey(height int64, name string) []byte {
+	return append(AuctionQueueHeightKey(height), name...)
+}
+
+// Deleted Names Cooling Down Are Stored By Name, And Queued By The Height They're Released
+var (
+	CooldownKeyPrefix      = []byte{0x0c}
+	CooldownQueueKeyPrefix = []byte{0x0d}
+)
+
+// CooldownKey returns the store key of name's cooldown
+func CooldownKey(name string) []byte {
+	return append(append([]byte{}, CooldownKeyPrefix...), name...)
+}
+
+// CooldownQueueHeightKey returns the prefix of the cooldowns ending at height
+func CooldownQueueHeightKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append([]byte{}, CooldownQueueKeyPrefix...), bz...)
+}
+
+// CooldownQueueKey returns the queue key of name's cooldown, ending at height
+func CooldownQueueKey(height int64, name string) []byte {
+	return append(CooldownQueueHeightKey(height), name...)
+}
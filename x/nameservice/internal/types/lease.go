@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Longest Lease, In Blocks, About A Year At Five Second Blocks
+	MaxLeaseBlocks int64 = 6311520
+
+	// Most Times A Name May Be Sublet Below Its Owner's Lease
+	MaxLeaseChain = 10
+)
+
+// Lease hands control of Name's value and records from Lessor, its owner or
+// the lessee it is sublet from, to Lessee for Blocks blocks. Fee is paid up
+// front when Period is zero, else every Period blocks out of an escrow the
+// lessee funds in full when accepting.
+type Lease struct {
+	Name   string         `json:"name"`
+	Lessor sdk.AccAddress `json:"lessor"`
+	Lessee sdk.AccAddress `json:"lessee"`
+	Blocks int64          `json:"blocks"`
+	Fee    sdk.Coins      `json:"fee"`
+	Period int64          `json:"period,omitempty"`
+
+	// Zero Until The Lessee Accepts The Offer
+	Start int64 `json:"start,omitempty"`
+	End   int64 `json:"end,omitempty"`
+
+	// The Height The Lessor Has Been Paid Up To, And What The Lessee Escrowed Beyond It
+	PaidThrough int64     `json:"paid_through,omitempty"`
+	Escrow      sdk.Coins `json:"escrow,omitempty"`
+}
+
+// ValidateLeaseTerms checks the terms a lease may be offered on
+func ValidateLeaseTerms(blocks int64, fee sdk.Coins, period int64) error {
+	if blocks <= 0 || blocks > MaxLeaseBlocks {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "A lease must run between 1 and %d blocks", MaxLeaseBlocks)
+	}
+
+	if !fee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fee.String())
+	}
+
+	if period < 0 || (period > 0 && blocks%period != 0) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Period must divide the lease's %d blocks", blocks)
+	}
+
+	return nil
+}
+
+// Accepted reports whether the lessee has taken up the lease
+func (l Lease) Accepted() bool {
+	return l.Start != 0
+}
+
+// Active reports whether the lessee controls the name at height
+func (l Lease) Active(height int64) bool {
+	return l.Accepted() && height < l.End
+}
+
+// Total is what the lessee pays over the whole lease
+func (l Lease) Total() sdk.Coins {
+	if l.Period == 0 {
+		return l.Fee
+	}
+
+	total := make(sdk.Coins, len(l.Fee))
+	for i, coin := range l.Fee {
+		total[i] = sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(l.Blocks/l.Period))
+	}
+	return total
+}
+
+func (l Lease) String() string {
+	terms := fmt.Sprintf("%s up front", l.Fee)
+	if l.Period != 0 {
+		terms = fmt.Sprintf("%s every %d blocks", l.Fee, l.Period)
+	}
+
+	status := "offered"
+	if l.Accepted() {
+		status = fmt.Sprintf("until block %d", l.End)
+	}
+
+	return fmt.Sprintf("%s\t%s\t%s\t%d blocks\t%s\t%s", l.Name, l.Lessor, l.Lessee, l.Blocks, terms, status)
+}
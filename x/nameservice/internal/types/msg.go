@@ -1,12 +1,15 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Structure Declarations
 
+// Owner Is The Signer, The Name's Owner Or An Operator Approved To Manage It
 type MsgSetName struct {
 	Name string				`json:"name"`
 	Value string			`json:"value"`	
@@ -24,6 +27,174 @@ type MsgDeleteName struct {
 	Owner sdk.AccAddress	`json:"owner"`
 }
 
+// Owner Is The Signer, The Name's Owner Or An Operator Approved For Its Records
+type MsgSetRecords struct {
+	Name string				`json:"name"`
+	Records []Record		`json:"records"`
+	Owner sdk.AccAddress	`json:"owner"`
+}
+
+type MsgSendToName struct {
+	Name string				`json:"name"`
+	Amount sdk.Coins		`json:"amount"`
+	Sender sdk.AccAddress	`json:"sender"`
+}
+
+// Applies Its Operations In Order, All Or None Of Them
+type MsgBatch struct {
+	Operations []BatchOperation	`json:"operations"`
+	Signer sdk.AccAddress		`json:"signer"`
+}
+
+// Most Operations One MsgBatch May Carry
+const MaxBatchOperations = 500
+
+// One Entry Of A MsgBatch, Exactly One Field Set
+type BatchOperation struct {
+	SetName *MsgSetName			`json:"set_name,omitempty"`
+	BuyName *MsgBuyName			`json:"buy_name,omitempty"`
+	DeleteName *MsgDeleteName	`json:"delete_name,omitempty"`
+	SetRecords *MsgSetRecords	`json:"set_records,omitempty"`
+}
+
+// An Empty Name Approves The Operator On All The Owner's Names
+type MsgApproveOperator struct {
+	Name string				`json:"name,omitempty"`
+	Operator sdk.AccAddress	`json:"operator"`
+	Scope string			`json:"scope"`
+	Expiry time.Time		`json:"expiry"`
+	Owner sdk.AccAddress	`json:"owner"`
+}
+
+type MsgRevokeOperator struct {
+	Name string				`json:"name,omitempty"`
+	Operator sdk.AccAddress	`json:"operator"`
+	Owner sdk.AccAddress	`json:"owner"`
+}
+
+// Moves Amount Into The Grantee's Allowance, Topping Up Any The Granter Already Gave.
+// SpendLimit Caps The Fee Of Any One Tx The Allowance Pays, Empty For No Cap.
+type MsgGrantFeeAllowance struct {
+	Grantee sdk.AccAddress	`json:"grantee"`
+	Amount sdk.Coins		`json:"amount"`
+	SpendLimit sdk.Coins	`json:"spend_limit"`
+	Expiry time.Time		`json:"expiry"`
+	Granter sdk.AccAddress	`json:"granter"`
+}
+
+type MsgRevokeFeeAllowance struct {
+	Grantee sdk.AccAddress	`json:"grantee"`
+	Granter sdk.AccAddress	`json:"granter"`
+}
+
+// Replaces A Name's Owners With Owners, Threshold Of Whom Must Approve What
+// Is Done To It From Then On. Signed By The Owner, Or By Any Owner Of A Name
+// One Owner Can Act On Alone.
+type MsgSetOwners struct {
+	Name string					`json:"name"`
+	Owners []sdk.AccAddress		`json:"owners"`
+	Threshold uint32			`json:"threshold"`
+	Owner sdk.AccAddress		`json:"owner"`
+}
+
+// Proposes An Action On A Multi-Owner Name, Counting As The Proposer's Approval.
+// A Zero Expiry Lets It Stand For DefaultProposalPeriod.
+type MsgPropose struct {
+	Name string					`json:"name"`
+	Action ProposalAction		`json:"action"`
+	Expiry time.Time			`json:"expiry"`
+	Proposer sdk.AccAddress		`json:"proposer"`
+}
+
+type MsgApproveProposal struct {
+	Name string					`json:"name"`
+	ID uint64					`json:"id"`
+	Owner sdk.AccAddress		`json:"owner"`
+}
+
+// Guards The Owner's Name, Or All The Names It Holds Alone When Name Is Empty.
+// No Guardians Removes The Set.
+type MsgSetGuardians struct {
+	Name string					`json:"name,omitempty"`
+	Guardians []sdk.AccAddress	`json:"guardians"`
+	Threshold uint32			`json:"threshold"`
+	Delay time.Duration			`json:"delay"`
+	Owner sdk.AccAddress		`json:"owner"`
+}
+
+// Starts Moving A Name To NewOwner, Counting As The Guardian's Approval
+type MsgStartRecovery struct {
+	Name string					`json:"name"`
+	NewOwner sdk.AccAddress		`json:"new_owner"`
+	Guardian sdk.AccAddress		`json:"guardian"`
+}
+
+type MsgApproveRecovery struct {
+	Name string					`json:"name"`
+	NewOwner sdk.AccAddress		`json:"new_owner"`
+	Guardian sdk.AccAddress		`json:"guardian"`
+}
+
+// Cancels The Recovery Of A Name To NewOwner, Or Every Recovery Of It When NewOwner Is Empty
+type MsgVetoRecovery struct {
+	Name string					`json:"name"`
+	NewOwner sdk.AccAddress		`json:"new_owner,omitempty"`
+	Owner sdk.AccAddress		`json:"owner"`
+}
+
+// Moves The Name Once The Recovery's Delay Is Over. Anyone May Sign It.
+type MsgFinalizeRecovery struct {
+	Name string					`json:"name"`
+	NewOwner sdk.AccAddress		`json:"new_owner"`
+	Signer sdk.AccAddress		`json:"signer"`
+}
+
+// Offers The Signer's Control Of A Name, As Its Owner Or Its Lessee, To Lessee
+// For Blocks Blocks. Fee Is Paid Up Front, Or Every Period Blocks When Set.
+type MsgOfferLease struct {
+	Name string					`json:"name"`
+	Lessee sdk.AccAddress		`json:"lessee"`
+	Blocks int64				`json:"blocks"`
+	Fee sdk.Coins				`json:"fee"`
+	Period int64				`json:"period,omitempty"`
+	Lessor sdk.AccAddress		`json:"lessor"`
+}
+
+// Takes Up The Lease Lessor Offered, Paying Its Fee
+type MsgAcceptLease struct {
+	Name string					`json:"name"`
+	Lessor sdk.AccAddress		`json:"lessor"`
+	Lessee sdk.AccAddress		`json:"lessee"`
+}
+
+// Withdraws Or Declines A Lease Offer, Or Lets The Lessee End Its Lease Early
+type MsgEndLease struct {
+	Name string					`json:"name"`
+	Lessor sdk.AccAddress		`json:"lessor"`
+	Signer sdk.AccAddress		`json:"signer"`
+}
+
+// Declares The Price Anyone May Buy The Name At, Putting It Under Harberger
+// Tax If It Isn't Already
+type MsgSelfAssess struct {
+	Name string					`json:"name"`
+	Price sdk.Coins				`json:"price"`
+	Owner sdk.AccAddress		`json:"owner"`
+}
+
+// Adds To The Deposit A Harberger Name's Tax Is Paid From. Anyone May Sign It.
+type MsgDepositTax struct {
+	Name string					`json:"name"`
+	Amount sdk.Coins			`json:"amount"`
+	Depositor sdk.AccAddress	`json:"depositor"`
+}
+
+type MsgWithdrawTax struct {
+	Name string					`json:"name"`
+	Amount sdk.Coins			`json:"amount"`
+	Owner sdk.AccAddress		`json:"owner"`
+}
+
 // Message Constructors
 
 func NewMsgSetName(name string, value string, owner sdk.AccAddress) MsgSetName {
@@ -49,17 +220,275 @@ func NewMsgDeleteName(name string, owner sdk.AccAddress) MsgDeleteName {
 	}
 }
 
+func NewMsgSetRecords(name string, records []Record, owner sdk.AccAddress) MsgSetRecords {
+	return MsgSetRecords {
+		Name: name,
+		Records: records,
+		Owner: owner,
+	}
+}
+
+func NewMsgSendToName(name string, amount sdk.Coins, sender sdk.AccAddress) MsgSendToName {
+	return MsgSendToName {
+		Name: name,
+		Amount: amount,
+		Sender: sender,
+	}
+}
+
+func NewMsgBatch(operations []BatchOperation, signer sdk.AccAddress) MsgBatch {
+	return MsgBatch {
+		Operations: operations,
+		Signer: signer,
+	}
+}
+
+func NewMsgApproveOperator(name string, operator sdk.AccAddress, scope string, expiry time.Time, owner sdk.AccAddress) MsgApproveOperator {
+	return MsgApproveOperator {
+		Name: name,
+		Operator: operator,
+		Scope: scope,
+		Expiry: expiry,
+		Owner: owner,
+	}
+}
+
+func NewMsgRevokeOperator(name string, operator sdk.AccAddress, owner sdk.AccAddress) MsgRevokeOperator {
+	return MsgRevokeOperator {
+		Name: name,
+		Operator: operator,
+		Owner: owner,
+	}
+}
+
+func NewMsgGrantFeeAllowance(grantee sdk.AccAddress, amount sdk.Coins, spendLimit sdk.Coins, expiry time.Time, granter sdk.AccAddress) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance {
+		Grantee: grantee,
+		Amount: amount,
+		SpendLimit: spendLimit,
+		Expiry: expiry,
+		Granter: granter,
+	}
+}
+
+func NewMsgRevokeFeeAllowance(grantee sdk.AccAddress, granter sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance {
+		Grantee: grantee,
+		Granter: granter,
+	}
+}
+
+func NewMsgSetOwners(name string, owners []sdk.AccAddress, threshold uint32, owner sdk.AccAddress) MsgSetOwners {
+	return MsgSetOwners {
+		Name: name,
+		Owners: owners,
+		Threshold: threshold,
+		Owner: owner,
+	}
+}
+
+func NewMsgPropose(name string, action ProposalAction, expiry time.Time, proposer sdk.AccAddress) MsgPropose {
+	return MsgPropose {
+		Name: name,
+		Action: action,
+		Expiry: expiry,
+		Proposer: proposer,
+	}
+}
+
+func NewMsgApproveProposal(name string, id uint64, owner sdk.AccAddress) MsgApproveProposal {
+	return MsgApproveProposal {
+		Name: name,
+		ID: id,
+		Owner: owner,
+	}
+}
+
+func NewMsgSetGuardians(name string, guardians []sdk.AccAddress, threshold uint32, delay time.Duration, owner sdk.AccAddress) MsgSetGuardians {
+	return MsgSetGuardians {
+		Name: name,
+		Guardians: guardians,
+		Threshold: threshold,
+		Delay: delay,
+		Owner: owner,
+	}
+}
+
+func NewMsgStartRecovery(name string, newOwner sdk.AccAddress, guardian sdk.AccAddress) MsgStartRecovery {
+	return MsgStartRecovery {
+		Name: name,
+		NewOwner: newOwner,
+		Guardian: guardian,
+	}
+}
+
+func NewMsgApproveRecovery(name string, newOwner sdk.AccAddress, guardian sdk.AccAddress) MsgApproveRecovery {
+	return MsgApproveRecovery {
+		Name: name,
+		NewOwner: newOwner,
+		Guardian: guardian,
+	}
+}
+
+func NewMsgVetoRecovery(name string, newOwner sdk.AccAddress, owner sdk.AccAddress) MsgVetoRecovery {
+	return MsgVetoRecovery {
+		Name: name,
+		NewOwner: newOwner,
+		Owner: owner,
+	}
+}
+
+func NewMsgFinalizeRecovery(name string, newOwner sdk.AccAddress, signer sdk.AccAddress) MsgFinalizeRecovery {
+	return MsgFinalizeRecovery {
+		Name: name,
+		NewOwner: newOwner,
+		Signer: signer,
+	}
+}
+
+func NewMsgOfferLease(name string, lessee sdk.AccAddress, blocks int64, fee sdk.Coins, period int64, lessor sdk.AccAddress) MsgOfferLease {
+	return MsgOfferLease {
+		Name: name,
+		Lessee: lessee,
+		Blocks: blocks,
+		Fee: fee,
+		Period: period,
+		Lessor: lessor,
+	}
+}
+
+func NewMsgAcceptLease(name string, lessor sdk.AccAddress, lessee sdk.AccAddress) MsgAcceptLease {
+	return MsgAcceptLease {
+		Name: name,
+		Lessor: lessor,
+		Lessee: lessee,
+	}
+}
+
+func NewMsgEndLease(name string, lessor sdk.AccAddress, signer sdk.AccAddress) MsgEndLease {
+	return MsgEndLease {
+		Name: name,
+		Lessor: lessor,
+		Signer: signer,
+	}
+}
+
+func NewMsgSelfAssess(name string, price sdk.Coins, owner sdk.AccAddress) MsgSelfAssess {
+	return MsgSelfAssess {
+		Name: name,
+		Price: price,
+		Owner: owner,
+	}
+}
+
+func NewMsgDepositTax(name string, amount sdk.Coins, depositor sdk.AccAddress) MsgDepositTax {
+	return MsgDepositTax {
+		Name: name,
+		Amount: amount,
+		Depositor: depositor,
+	}
+}
+
+func NewMsgWithdrawTax(name string, amount sdk.Coins, owner sdk.AccAddress) MsgWithdrawTax {
+	return MsgWithdrawTax {
+		Name: name,
+		Amount: amount,
+		Owner: owner,
+	}
+}
+
+// NewBatchOperation wraps msg, which must be one of the messages a batch can carry
+func NewBatchOperation(msg sdk.Msg) (BatchOperation, error) {
+	switch msg := msg.(type) {
+	case MsgSetName:
+		return BatchOperation{SetName: &msg}, nil
+	case MsgBuyName:
+		return BatchOperation{BuyName: &msg}, nil
+	case MsgDeleteName:
+		return BatchOperation{DeleteName: &msg}, nil
+	case MsgSetRecords:
+		return BatchOperation{SetRecords: &msg}, nil
+	default:
+		return BatchOperation{}, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%T can't be batched", msg)
+	}
+}
+
+// Msg returns the message op carries
+func (op BatchOperation) Msg() (sdk.Msg, error) {
+	var msgs []sdk.Msg
+	if op.SetName != nil {
+		msgs = append(msgs, *op.SetName)
+	}
+	if op.BuyName != nil {
+		msgs = append(msgs, *op.BuyName)
+	}
+	if op.DeleteName != nil {
+		msgs = append(msgs, *op.DeleteName)
+	}
+	if op.SetRecords != nil {
+		msgs = append(msgs, *op.SetRecords)
+	}
+
+	if len(msgs) != 1 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "batch operation must carry exactly one message, has %d", len(msgs))
+	}
+
+	return msgs[0], nil
+}
+
 // Message Route Declarations
 
 func (msg MsgSetName) Route() string { return RouterKey }
 func (msg MsgBuyName) Route() string { return RouterKey }
 func (msg MsgDeleteName) Route() string { return RouterKey }
+func (msg MsgSetRecords) Route() string { return RouterKey }
+func (msg MsgSendToName) Route() string { return RouterKey }
+func (msg MsgBatch) Route() string { return RouterKey }
+func (msg MsgApproveOperator) Route() string { return RouterKey }
+func (msg MsgRevokeOperator) Route() string { return RouterKey }
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+func (msg MsgSetOwners) Route() string { return RouterKey }
+func (msg MsgPropose) Route() string { return RouterKey }
+func (msg MsgApproveProposal) Route() string { return RouterKey }
+func (msg MsgSetGuardians) Route() string { return RouterKey }
+func (msg MsgStartRecovery) Route() string { return RouterKey }
+func (msg MsgApproveRecovery) Route() string { return RouterKey }
+func (msg MsgVetoRecovery) Route() string { return RouterKey }
+func (msg MsgFinalizeRecovery) Route() string { return RouterKey }
+func (msg MsgOfferLease) Route() string { return RouterKey }
+func (msg MsgAcceptLease) Route() string { return RouterKey }
+func (msg MsgEndLease) Route() string { return RouterKey }
+func (msg MsgSelfAssess) Route() string { return RouterKey }
+func (msg MsgDepositTax) Route() string { return RouterKey }
+func (msg MsgWithdrawTax) Route() string { return RouterKey }
 
 // Message Type Declarations
 
 func (msg MsgSetName) Type() string { return "set_name" }
 func (msg MsgBuyName) Type() string {return "buy_name"}
 func (msg MsgDeleteName) Type() string { return "delete_name" }
+func (msg MsgSetRecords) Type() string { return "set_records" }
+func (msg MsgSendToName) Type() string { return "send_to_name" }
+func (msg MsgBatch) Type() string { return "batch" }
+func (msg MsgApproveOperator) Type() string { return "approve_operator" }
+func (msg MsgRevokeOperator) Type() string { return "revoke_operator" }
+func (msg MsgGrantFeeAllowance) Type() string { return "grant_fee_allowance" }
+func (msg MsgRevokeFeeAllowance) Type() string { return "revoke_fee_allowance" }
+func (msg MsgSetOwners) Type() string { return "set_owners" }
+func (msg MsgPropose) Type() string { return "propose" }
+func (msg MsgApproveProposal) Type() string { return "approve_proposal" }
+func (msg MsgSetGuardians) Type() string { return "set_guardians" }
+func (msg MsgStartRecovery) Type() string { return "start_recovery" }
+func (msg MsgApproveRecovery) Type() string { return "approve_recovery" }
+func (msg MsgVetoRecovery) Type() string { return "veto_recovery" }
+func (msg MsgFinalizeRecovery) Type() string { return "finalize_recovery" }
+func (msg MsgOfferLease) Type() string { return "offer_lease" }
+func (msg MsgAcceptLease) Type() string { return "accept_lease" }
+func (msg MsgEndLease) Type() string { return "end_lease" }
+func (msg MsgSelfAssess) Type() string { return "self_assess" }
+func (msg MsgDepositTax) Type() string { return "deposit_tax" }
+func (msg MsgWithdrawTax) Type() string { return "withdraw_tax" }
 
 // Stateless Checks
 
@@ -80,8 +509,9 @@ func (msg MsgBuyName) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Buyer.String())
 	}
 
-	if len(msg.Name) == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	// Buying Enforces The Naming Rules, Other Messages Act On Names That Already Exist
+	if err := ValidateName(msg.Name); err != nil {
+		return err
 	}
 
 	if !msg.Bid.IsAllPositive() {
@@ -103,6 +533,308 @@ func (msg MsgDeleteName) ValidateBasic() error {
 	return nil
 }
 
+func (msg MsgSetRecords) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	// An Empty List Clears The Name's Records
+	for _, record := range msg.Records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgSendToName) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender.String())
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgBatch) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Signer.String())
+	}
+
+	if len(msg.Operations) == 0 || len(msg.Operations) > MaxBatchOperations {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Batch must carry between 1 and %d operations", MaxBatchOperations)
+	}
+
+	// Every Operation Is Checked Up Front, So A Bad One Fails The Tx Before Any Runs
+	for i, op := range msg.Operations {
+		m, err := op.Msg()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "operation %d", i)
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "operation %d", i)
+		}
+
+		// The Batch's Signature Has To Cover Every Operation
+		signers := m.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(msg.Signer) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "operation %d isn't signed by %s", i, msg.Signer)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgApproveOperator) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if msg.Operator.Empty() || msg.Operator.Equals(msg.Owner) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Operator must be set and differ from the owner")
+	}
+
+	if msg.Name != "" {
+		if err := ValidateName(msg.Name); err != nil {
+			return err
+		}
+	}
+
+	return ValidateScope(msg.Scope)
+}
+
+func (msg MsgRevokeOperator) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if msg.Operator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Operator.String())
+	}
+
+	return nil
+}
+
+func (msg MsgGrantFeeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Granter.String())
+	}
+
+	if msg.Grantee.Empty() || msg.Grantee.Equals(msg.Granter) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Grantee must be set and differ from the granter")
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	if !msg.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid spend limit %s", msg.SpendLimit)
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeFeeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Granter.String())
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Grantee.String())
+	}
+
+	return nil
+}
+
+func (msg MsgSetOwners) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	return ValidateOwners(msg.Owners, msg.Threshold)
+}
+
+func (msg MsgPropose) ValidateBasic() error {
+	if msg.Proposer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Proposer.String())
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	return msg.Action.Validate()
+}
+
+func (msg MsgApproveProposal) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg MsgSetGuardians) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if msg.Name != "" {
+		if err := ValidateName(msg.Name); err != nil {
+			return err
+		}
+	}
+
+	// Removing The Set
+	if len(msg.Guardians) == 0 {
+		return nil
+	}
+
+	return ValidateGuardians(msg.Owner, msg.Guardians, msg.Threshold, msg.Delay)
+}
+
+func (msg MsgStartRecovery) ValidateBasic() error {
+	return validateRecoveryMsg(msg.Name, msg.NewOwner, msg.Guardian)
+}
+
+func (msg MsgApproveRecovery) ValidateBasic() error {
+	return validateRecoveryMsg(msg.Name, msg.NewOwner, msg.Guardian)
+}
+
+func (msg MsgVetoRecovery) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg MsgFinalizeRecovery) ValidateBasic() error {
+	return validateRecoveryMsg(msg.Name, msg.NewOwner, msg.Signer)
+}
+
+func validateRecoveryMsg(name string, newOwner sdk.AccAddress, signer sdk.AccAddress) error {
+	if signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, signer.String())
+	}
+
+	if newOwner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "New owner cannot be empty")
+	}
+
+	if len(name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg MsgOfferLease) ValidateBasic() error {
+	if msg.Lessor.Empty() || msg.Lessee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Lessor and lessee cannot be empty")
+	}
+
+	if msg.Lessee.Equals(msg.Lessor) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "A name can't be leased to its lessor")
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	return ValidateLeaseTerms(msg.Blocks, msg.Fee, msg.Period)
+}
+
+func (msg MsgAcceptLease) ValidateBasic() error {
+	return validateLeaseMsg(msg.Name, msg.Lessor, msg.Lessee)
+}
+
+func (msg MsgEndLease) ValidateBasic() error {
+	return validateLeaseMsg(msg.Name, msg.Lessor, msg.Signer)
+}
+
+func validateLeaseMsg(name string, lessor sdk.AccAddress, signer sdk.AccAddress) error {
+	if signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, signer.String())
+	}
+
+	if lessor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Lessor cannot be empty")
+	}
+
+	if len(name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg MsgSelfAssess) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Owner.String())
+	}
+
+	if len(msg.Name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	if !msg.Price.IsValid() || !msg.Price.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Price.String())
+	}
+
+	return nil
+}
+
+func (msg MsgDepositTax) ValidateBasic() error {
+	return validateTaxMsg(msg.Name, msg.Amount, msg.Depositor)
+}
+
+func (msg MsgWithdrawTax) ValidateBasic() error {
+	return validateTaxMsg(msg.Name, msg.Amount, msg.Owner)
+}
+
+func validateTaxMsg(name string, amount sdk.Coins, signer sdk.AccAddress) error {
+	if signer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, signer.String())
+	}
+
+	if len(name) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Name cannot be empty")
+	}
+
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	return nil
+}
+
 // Message Sign Bytes Getter
 
 func (msg MsgSetName) GetSignBytes() []byte {
@@ -117,6 +849,90 @@ func (msg MsgDeleteName) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg)) 
 }
 
+func (msg MsgSetRecords) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSendToName) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApproveOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeOperator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetOwners) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgPropose) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApproveProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSetGuardians) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgStartRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgApproveRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgVetoRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgFinalizeRecovery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgOfferLease) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgAcceptLease) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgEndLease) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgSelfAssess) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDepositTax) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawTax) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
 // Message Signers Getter
 
 func (msg MsgSetName) GetSigners() []sdk.AccAddress {
@@ -131,3 +947,86 @@ func (msg MsgDeleteName) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+func (msg MsgSetRecords) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgSendToName) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+func (msg MsgApproveOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgRevokeOperator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgSetOwners) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgPropose) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+func (msg MsgApproveProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgSetGuardians) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgStartRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Guardian}
+}
+
+func (msg MsgApproveRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Guardian}
+}
+
+func (msg MsgVetoRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgFinalizeRecovery) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+func (msg MsgOfferLease) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Lessor}
+}
+
+func (msg MsgAcceptLease) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Lessee}
+}
+
+func (msg MsgEndLease) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+func (msg MsgSelfAssess) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+func (msg MsgDepositTax) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+func (msg MsgWithdrawTax) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
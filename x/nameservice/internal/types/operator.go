@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Scopes An Operator May Be Approved For. There Is No Renewal Scope: Names
+// Don't Expire, And Anyone May Top Up A Harberger Tax Deposit.
+const (
+	// Replacing The Name's Typed Records, Other Than ADDR Records
+	ScopeRecords = "records"
+
+	// Everything The Owner Can Do Except Transferring, Selling Or Deleting The Name
+	ScopeManage = "manage"
+)
+
+// OperatorApproval lets Operator act on Owner's behalf within Scope, on Name
+// or, when Name is empty, on every name Owner holds
+type OperatorApproval struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Name     string         `json:"name,omitempty"`
+	Operator sdk.AccAddress `json:"operator"`
+	Scope    string         `json:"scope"`
+
+	// The Zero Time Never Expires
+	Expiry time.Time `json:"expiry"`
+}
+
+// ValidateScope checks scope is one an operator may be approved for
+func ValidateScope(scope string) error {
+	switch scope {
+	case ScopeRecords, ScopeManage:
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown operator scope %q, expected %s or %s", scope, ScopeRecords, ScopeManage)
+	}
+}
+
+// Allows reports whether the approval covers scope. Managing a name covers
+// its records.
+func (a OperatorApproval) Allows(scope string) bool {
+	return a.Scope == scope || a.Scope == ScopeManage
+}
+
+// Expired reports whether the approval no longer holds at now
+func (a OperatorApproval) Expired(now time.Time) bool {
+	return !a.Expiry.IsZero() && !now.Before(a.Expiry)
+}
+
+func (a OperatorApproval) String() string {
+	name := a.Name
+	if name == "" {
+		name = "*"
+	}
+
+	expiry := "never"
+	if !a.Expiry.IsZero() {
+		expiry = a.Expiry.UTC().Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf("%s\t%s\t%s\t%s", name, a.Operator, a.Scope, expiry)
+}
@@ -3,19 +3,56 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Default parameter namespace
 const (
 	DefaultParamspace = ModuleName
-	// TODO: Define your default parameters
+
+	// About A Day At Five Second Blocks
+	DefaultTaxPeriod int64 = 17280
+
+	// About A Week At Five Second Blocks
+	DefaultAuctionBlocks int64 = 120960
+
+	// An Auctioned Name Goes To The First Bid To Meet Its Falling Price. Where
+	// Governance Opens A Bidding Window, A Bid In Its Last Minute Pushes The
+	// Close Back A Minute, By Up To An Hour In All
+	DefaultAuctionBidBlocks    int64 = 0
+	DefaultAuctionExtendWindow int64 = 12
+	DefaultAuctionExtendBlocks int64 = 12
+	DefaultAuctionMaxExtension int64 = 720
+
+	// A Deleted Name Is Held For Its Last Owners About A Day
+	DefaultDeleteCooldownBlocks int64 = 17280
 )
 
+// One Percent Of A Name's Declared Price Every Tax Period
+var DefaultTaxRate = sdk.NewDecWithPrec(1, 2)
+
+// Released Names Start At Ten Times Their Last Price
+var DefaultAuctionStartMultiple = sdk.NewDec(10)
+
+// Owners Deleting A Name Get Back Half Of What Registering It Cost
+var DefaultDeleteRefundRate = sdk.NewDecWithPrec(5, 1)
+
 // Parameter store keys
 var (
-	// TODO: Define your keys for the parameter store
-	// KeyParamName          = []byte("ParamName")
+	KeyHarbergerDefault = []byte("HarbergerDefault")
+	KeyTaxRate          = []byte("TaxRate")
+	KeyTaxPeriod        = []byte("TaxPeriod")
+
+	KeyAuctionBlocks        = []byte("AuctionBlocks")
+	KeyAuctionStartMultiple = []byte("AuctionStartMultiple")
+	KeyAuctionBidBlocks     = []byte("AuctionBidBlocks")
+	KeyAuctionExtendWindow  = []byte("AuctionExtendWindow")
+	KeyAuctionExtendBlocks  = []byte("AuctionExtendBlocks")
+	KeyAuctionMaxExtension  = []byte("AuctionMaxExtension")
+
+	KeyDeleteRefundRate     = []byte("DeleteRefundRate")
+	KeyDeleteCooldownBlocks = []byte("DeleteCooldownBlocks")
 )
 
 // ParamKeyTable for nameservice module
@@ -25,33 +62,197 @@ func ParamKeyTable() params.KeyTable {
 
 // Params - used for initializing default parameter for nameservice at genesis
 type Params struct {
-	// TODO: Add your Paramaters to the Paramter struct
-	// KeyParamName string `json:"key_param_name"`
+	// New Names Are Registered Under Harberger Tax When Set
+	HarbergerDefault bool `json:"harberger_default"`
+
+	// Fraction Of Its Declared Price A Harberger Name Owes Every TaxPeriod Blocks
+	TaxRate   sdk.Dec `json:"tax_rate"`
+	TaxPeriod int64   `json:"tax_period"`
+
+	// Released Names Are Auctioned Starting At AuctionStartMultiple Times
+	// Their Last Price, Falling To The Minimum Over AuctionBlocks Blocks
+	AuctionBlocks        int64   `json:"auction_blocks"`
+	AuctionStartMultiple sdk.Dec `json:"auction_start_multiple"`
+
+	// The First Bid Opens Bidding For AuctionBidBlocks Blocks, Zero Selling
+	// The Name Outright. A Bid In The Last AuctionExtendWindow Blocks Pushes
+	// The Close Back AuctionExtendBlocks, By AuctionMaxExtension At Most.
+	AuctionBidBlocks    int64 `json:"auction_bid_blocks"`
+	AuctionExtendWindow int64 `json:"auction_extend_window"`
+	AuctionExtendBlocks int64 `json:"auction_extend_blocks"`
+	AuctionMaxExtension int64 `json:"auction_max_extension"`
+
+	// Deleting A Name Refunds Its Owners DeleteRefundRate Of The Price Escrowed
+	// When It Was Registered, The Rest Going To The Community Pool, And Holds
+	// It For Them Alone To Register Again For DeleteCooldownBlocks Blocks
+	DeleteRefundRate     sdk.Dec `json:"delete_refund_rate"`
+	DeleteCooldownBlocks int64   `json:"delete_cooldown_blocks"`
 }
 
 // NewParams creates a new Params object
-func NewParams(/* TODO: Pass in the paramters*/) Params {
+func NewParams(
+	harbergerDefault bool, taxRate sdk.Dec, taxPeriod, auctionBlocks int64, auctionStartMultiple sdk.Dec,
+	auctionBidBlocks, auctionExtendWindow, auctionExtendBlocks, auctionMaxExtension int64,
+	deleteRefundRate sdk.Dec, deleteCooldownBlocks int64,
+) Params {
 	return Params{
-		// TODO: Create your Params Type
+		HarbergerDefault:     harbergerDefault,
+		TaxRate:              taxRate,
+		TaxPeriod:            taxPeriod,
+		AuctionBlocks:        auctionBlocks,
+		AuctionStartMultiple: auctionStartMultiple,
+		AuctionBidBlocks:     auctionBidBlocks,
+		AuctionExtendWindow:  auctionExtendWindow,
+		AuctionExtendBlocks:  auctionExtendBlocks,
+		AuctionMaxExtension:  auctionMaxExtension,
+		DeleteRefundRate:     deleteRefundRate,
+		DeleteCooldownBlocks: deleteCooldownBlocks,
 	}
 }
 
 // String implements the stringer interface for Params
 func (p Params) String() string {
-	return fmt.Sprintf(`
-	// TODO: Return all the params as a string
-	`, )
+	return fmt.Sprintf(`Harberger Default:      %t
+Tax Rate:               %s
+Tax Period:             %d blocks
+Auction Blocks:         %d
+Auction Start Multiple: %s
+Auction Bid Blocks:     %d
+Auction Extend Window:  %d
+Auction Extend Blocks:  %d
+Auction Max Extension:  %d
+Delete Refund Rate:     %s
+Delete Cooldown Blocks: %d`, p.HarbergerDefault, p.TaxRate, p.TaxPeriod, p.AuctionBlocks, p.AuctionStartMultiple,
+		p.AuctionBidBlocks, p.AuctionExtendWindow, p.AuctionExtendBlocks, p.AuctionMaxExtension,
+		p.DeleteRefundRate, p.DeleteCooldownBlocks)
+}
+
+// Validate checks the params hold values the module can work with
+func (p Params) Validate() error {
+	if err := validateTaxRate(p.TaxRate); err != nil {
+		return err
+	}
+	if err := validateTaxPeriod(p.TaxPeriod); err != nil {
+		return err
+	}
+	if err := validateAuctionBlocks(p.AuctionBlocks); err != nil {
+		return err
+	}
+	if err := validateAuctionStartMultiple(p.AuctionStartMultiple); err != nil {
+		return err
+	}
+	if err := validateDeleteRefundRate(p.DeleteRefundRate); err != nil {
+		return err
+	}
+	for _, blocks := range []int64{p.AuctionBidBlocks, p.AuctionExtendWindow, p.AuctionExtendBlocks, p.AuctionMaxExtension, p.DeleteCooldownBlocks} {
+		if err := validateNonNegativeBlocks(blocks); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // ParamSetPairs - Implements params.ParamSet
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
-		// TODO: Pair your key with the param
-		// params.NewParamSetPair(KeyParamName, &p.ParamName),
+		params.NewParamSetPair(KeyHarbergerDefault, &p.HarbergerDefault, validateHarbergerDefault),
+		params.NewParamSetPair(KeyTaxRate, &p.TaxRate, validateTaxRate),
+		params.NewParamSetPair(KeyTaxPeriod, &p.TaxPeriod, validateTaxPeriod),
+		params.NewParamSetPair(KeyAuctionBlocks, &p.AuctionBlocks, validateAuctionBlocks),
+		params.NewParamSetPair(KeyAuctionStartMultiple, &p.AuctionStartMultiple, validateAuctionStartMultiple),
+		params.NewParamSetPair(KeyAuctionBidBlocks, &p.AuctionBidBlocks, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyAuctionExtendWindow, &p.AuctionExtendWindow, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyAuctionExtendBlocks, &p.AuctionExtendBlocks, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyAuctionMaxExtension, &p.AuctionMaxExtension, validateNonNegativeBlocks),
+		params.NewParamSetPair(KeyDeleteRefundRate, &p.DeleteRefundRate, validateDeleteRefundRate),
+		params.NewParamSetPair(KeyDeleteCooldownBlocks, &p.DeleteCooldownBlocks, validateNonNegativeBlocks),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams( /* TODO: Pass in your default Params */ )
+	return NewParams(
+		false, DefaultTaxRate, DefaultTaxPeriod, DefaultAuctionBlocks, DefaultAuctionStartMultiple,
+		DefaultAuctionBidBlocks, DefaultAuctionExtendWindow, DefaultAuctionExtendBlocks, DefaultAuctionMaxExtension,
+		DefaultDeleteRefundRate, DefaultDeleteCooldownBlocks,
+	)
+}
+
+func validateHarbergerDefault(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTaxRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("tax rate must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateTaxPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("tax period must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateAuctionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("auction blocks must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateAuctionStartMultiple(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("auction start multiple must be at least 1: %s", v)
+	}
+	return nil
+}
+
+func validateNonNegativeBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("block count must not be negative: %d", v)
+	}
+	return nil
+}
+
+func validateDeleteRefundRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("delete refund rate must be between 0 and 1: %s", v)
+	}
+	return nil
 }
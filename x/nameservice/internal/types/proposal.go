@@ -0,0 +1,147 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Actions A Proposal On A Multi-Owner Name May Carry
+const (
+	// Setting The Name's Value
+	ActionSetValue = "set-value"
+
+	// Handing The Name To Another Owner, Or Set Of Owners And Threshold
+	ActionTransfer = "transfer"
+
+	// Letting One Buyer Buy The Name At A Price
+	ActionSell = "sell"
+
+	// Deleting The Name
+	ActionDelete = "delete"
+)
+
+const (
+	// Most Owners A Name May Have
+	MaxOwners = 20
+
+	// How Long A Proposal Stands When It Doesn't Set An Expiry
+	DefaultProposalPeriod = 7 * 24 * time.Hour
+)
+
+// ProposalAction is what a proposal does once enough owners approve it. Type
+// says which of the other fields are set.
+type ProposalAction struct {
+	Type string `json:"type"`
+
+	// ActionSetValue
+	Value string `json:"value,omitempty"`
+
+	// ActionTransfer
+	Owners    []sdk.AccAddress `json:"owners,omitempty"`
+	Threshold uint32           `json:"threshold,omitempty"`
+
+	// ActionSell
+	Buyer sdk.AccAddress `json:"buyer,omitempty"`
+	Price sdk.Coins      `json:"price,omitempty"`
+}
+
+// Validate checks the action carries what its type needs
+func (a ProposalAction) Validate() error {
+	switch a.Type {
+	case ActionSetValue:
+		if len(a.Value) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "Value cannot be empty")
+		}
+	case ActionTransfer:
+		return ValidateOwners(a.Owners, a.Threshold)
+	case ActionSell:
+		if a.Buyer.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Buyer cannot be empty")
+		}
+
+		if !a.Price.IsValid() || !a.Price.IsAllPositive() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, a.Price.String())
+		}
+	case ActionDelete:
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown proposal action %q, expected %s, %s, %s or %s",
+			a.Type, ActionSetValue, ActionTransfer, ActionSell, ActionDelete)
+	}
+
+	return nil
+}
+
+func (a ProposalAction) String() string {
+	switch a.Type {
+	case ActionSetValue:
+		return fmt.Sprintf("%s %s", a.Type, a.Value)
+	case ActionTransfer:
+		return fmt.Sprintf("%s %s (%d of %d)", a.Type, joinAddresses(a.Owners), a.Threshold, len(a.Owners))
+	case ActionSell:
+		return fmt.Sprintf("%s %s %s", a.Type, a.Buyer, a.Price)
+	default:
+		return a.Type
+	}
+}
+
+// ValidateOwners checks a set of owners and the threshold of them needed to
+// act on a name
+func ValidateOwners(owners []sdk.AccAddress, threshold uint32) error {
+	if len(owners) == 0 || len(owners) > MaxOwners {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "A name must have between 1 and %d owners", MaxOwners)
+	}
+
+	seen := make(map[string]bool)
+	for _, owner := range owners {
+		if owner.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Owner cannot be empty")
+		}
+
+		if seen[owner.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s is listed twice", owner)
+		}
+		seen[owner.String()] = true
+	}
+
+	if threshold == 0 || int(threshold) > len(owners) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Threshold must be between 1 and the %d owners", len(owners))
+	}
+
+	return nil
+}
+
+// Proposal is an action on a multi-owner name, taken once Threshold of its
+// owners approve it
+type Proposal struct {
+	ID        uint64           `json:"id"`
+	Name      string           `json:"name"`
+	Action    ProposalAction   `json:"action"`
+	Proposer  sdk.AccAddress   `json:"proposer"`
+	Approvals []sdk.AccAddress `json:"approvals"`
+	Expiry    time.Time        `json:"expiry"`
+
+	// A Sale That Reached The Threshold Waits Here For Its Buyer Until It Expires
+	Passed bool `json:"passed,omitempty"`
+}
+
+// Expired reports whether the proposal no longer stands at now
+func (p Proposal) Expired(now time.Time) bool {
+	return !now.Before(p.Expiry)
+}
+
+// HasApproved reports whether owner has approved the proposal
+func (p Proposal) HasApproved(owner sdk.AccAddress) bool {
+	return containsAddress(p.Approvals, owner)
+}
+
+func (p Proposal) String() string {
+	status := "open"
+	if p.Passed {
+		status = "passed"
+	}
+
+	return fmt.Sprintf("%d\t%s\t%d\t%s\t%s", p.ID, p.Action, len(p.Approvals), status, p.Expiry.UTC().Format(time.RFC3339))
+}
@@ -1,6 +1,11 @@
 package types
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // Querier Types 
 
@@ -10,12 +15,130 @@ type QueryResResolve struct {
 
 type QueryResNames []string
 
+type QueryResRecords []Record
+
+type QueryResAddress struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// Names Held By One Of The Owners Asked About In A Batch
+type OwnedNames struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Names []string       `json:"names"`
+}
+
+type QueryResOwnedBy []OwnedNames
+
+// Unexpired Approvals Covering A Name, Its Own And Its Owner's All-Names Ones
+type QueryResOperators []OperatorApproval
+
+// Every Allowance Granted To A Grantee, Expired Or Not
+type QueryResFeeAllowances []FeeAllowance
+
+// Unexpired Proposals On A Name, Oldest First
+type QueryResProposals []Proposal
+
+// Pending And Approved Recoveries Of A Name
+type QueryResRecoveries []Recovery
+
+// Leases Of A Name, From Its Owner's Down To The Latest Sublease, And Offers Of Them
+type QueryResLeases []Lease
+
 // Implement fmt.Stringer
 
 func (r QueryResResolve) String() string {
 	return r.Value
 }
 
+func (r QueryResAddress) String() string {
+	return r.Address.String()
+}
+
 func (n QueryResNames) String() string {
 	return strings.Join(n[:], "\n")
 }
+
+func (r QueryResOperators) String() string {
+	lines := make([]string, len(r))
+	for i, approval := range r {
+		lines[i] = approval.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r QueryResFeeAllowances) String() string {
+	lines := make([]string, len(r))
+	for i, allowance := range r {
+		lines[i] = allowance.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r QueryResProposals) String() string {
+	lines := make([]string, len(r))
+	for i, proposal := range r {
+		lines[i] = proposal.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r QueryResRecoveries) String() string {
+	lines := make([]string, len(r))
+	for i, recovery := range r {
+		lines[i] = recovery.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Where A Name Stands In Its Lifecycle
+const (
+	StatusAvailable		= "available"
+	StatusRegistered	= "registered"
+	StatusLeased		= "leased"
+	StatusAuction		= "auction"
+	StatusBidding		= "bidding"
+	StatusCooldown		= "cooldown"
+)
+
+// QueryResStatus Is A Name's Status, What It Takes To Get It Now, And
+// Whichever Of Its whoIs, Leases, Auction Or Cooldown Explain That
+type QueryResStatus struct {
+	Name string				`json:"name"`
+	Status string			`json:"status"`
+	Price sdk.Coins			`json:"price"`
+	WhoIs *WhoIs			`json:"whois,omitempty"`
+	TaxOwed sdk.Coins		`json:"tax_owed,omitempty"`
+	Leases []Lease			`json:"leases,omitempty"`
+	Auction *Auction		`json:"auction,omitempty"`
+	Cooldown *Cooldown		`json:"cooldown,omitempty"`
+}
+
+func (s QueryResStatus) String() string {
+	return fmt.Sprintf("%s\t%s\t%s", s.Name, s.Status, s.Price)
+}
+
+// QueryResAuction Is An Auction Along With What The Name Sells For Now
+type QueryResAuction struct {
+	Auction Auction		`json:"auction"`
+	Price sdk.Coins		`json:"price"`
+}
+
+func (a QueryResAuction) String() string {
+	return fmt.Sprintf("%s\tnow %s", a.Auction, a.Price)
+}
+
+func (l QueryResLeases) String() string {
+	lines := make([]string, len(l))
+	for i, lease := range l {
+		lines[i] = lease.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (r QueryResRecords) String() string {
+	lines := make([]string, len(r))
+	for i, record := range r {
+		lines[i] = record.String()
+	}
+	return strings.Join(lines, "\n")
+}
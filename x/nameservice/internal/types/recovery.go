@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// Most Guardians A Set May Have
+	MaxGuardians = 20
+
+	// Shortest Delay A Guardian Set May Give An Owner To Veto A Recovery
+	MinRecoveryDelay = 24 * time.Hour
+
+	// How Long A Recovery Waits For Enough Guardians To Approve It
+	RecoveryApprovalPeriod = 7 * 24 * time.Hour
+)
+
+// GuardianSet lets Threshold of Guardians move Owner's name, or every name
+// Owner holds alone when Name is empty, to a new owner. The move happens
+// Delay after they agree, unless Owner vetoes it first.
+type GuardianSet struct {
+	Owner     sdk.AccAddress   `json:"owner"`
+	Name      string           `json:"name,omitempty"`
+	Guardians []sdk.AccAddress `json:"guardians"`
+	Threshold uint32           `json:"threshold"`
+	Delay     time.Duration    `json:"delay"`
+}
+
+// ValidateGuardians checks a guardian set owner may register
+func ValidateGuardians(owner sdk.AccAddress, guardians []sdk.AccAddress, threshold uint32, delay time.Duration) error {
+	if len(guardians) == 0 || len(guardians) > MaxGuardians {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "A guardian set must have between 1 and %d guardians", MaxGuardians)
+	}
+
+	seen := make(map[string]bool)
+	for _, guardian := range guardians {
+		if guardian.Empty() || guardian.Equals(owner) {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Guardians must be set and differ from the owner")
+		}
+
+		if seen[guardian.String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "%s is listed twice", guardian)
+		}
+		seen[guardian.String()] = true
+	}
+
+	if threshold == 0 || int(threshold) > len(guardians) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Threshold must be between 1 and the %d guardians", len(guardians))
+	}
+
+	if delay < MinRecoveryDelay {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "Delay must be at least %s", MinRecoveryDelay)
+	}
+
+	return nil
+}
+
+// IsGuardian reports whether addr is one of the set's guardians
+func (g GuardianSet) IsGuardian(addr sdk.AccAddress) bool {
+	return containsAddress(g.Guardians, addr)
+}
+
+func (g GuardianSet) String() string {
+	name := g.Name
+	if name == "" {
+		name = "*"
+	}
+
+	return fmt.Sprintf("%s\t%s\t%d of %d\t%s", name, joinAddresses(g.Guardians), g.Threshold, len(g.Guardians), g.Delay)
+}
+
+// Recovery is the guardians' move of Name from Owner to NewOwner. It takes
+// the threshold and delay of the guardian set it was started under.
+type Recovery struct {
+	Name      string           `json:"name"`
+	Owner     sdk.AccAddress   `json:"owner"`
+	NewOwner  sdk.AccAddress   `json:"new_owner"`
+	Guardians []sdk.AccAddress `json:"guardians"`
+	Threshold uint32           `json:"threshold"`
+	Delay     time.Duration    `json:"delay"`
+	Approvals []sdk.AccAddress `json:"approvals"`
+	Started   time.Time        `json:"started"`
+
+	// Zero Until Enough Guardians Approve, Then When The Name Can Be Moved
+	ReadyAt time.Time `json:"ready_at"`
+}
+
+// Approved reports whether enough guardians have approved the recovery
+func (r Recovery) Approved() bool {
+	return !r.ReadyAt.IsZero()
+}
+
+// Expired reports whether the recovery failed to gather enough approvals
+// in time
+func (r Recovery) Expired(now time.Time) bool {
+	return !r.Approved() && !now.Before(r.Started.Add(RecoveryApprovalPeriod))
+}
+
+// Ready reports whether the name can be moved at now
+func (r Recovery) Ready(now time.Time) bool {
+	return r.Approved() && !now.Before(r.ReadyAt)
+}
+
+// HasApproved reports whether guardian has approved the recovery
+func (r Recovery) HasApproved(guardian sdk.AccAddress) bool {
+	return containsAddress(r.Approvals, guardian)
+}
+
+func (r Recovery) String() string {
+	status := "pending"
+	if r.Approved() {
+		status = "ready at " + r.ReadyAt.UTC().Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf("%s\t%s\t%d of %d\t%s", r.Name, r.NewOwner, len(r.Approvals), r.Threshold, status)
+}
+
+func containsAddress(addrs []sdk.AccAddress, addr sdk.AccAddress) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinAddresses(addrs []sdk.AccAddress) string {
+	strs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		strs[i] = addr.String()
+	}
+	return strings.Join(strs, ",")
+}
@@ -2,18 +2,61 @@ package types
 
 import (
 	"fmt"
+	"net"
+	"regexp"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // Initial Name Value
 var minNamePrice = sdk.Coins{sdk.NewInt64Coin("nametoken", 1)}
 
+// Names Are Lower-Case DNS Labels, Optionally Dot Separated, So They Can Be Served Under A Zone
+var nameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*$`)
+
+// Longest Name That Still Fits A Domain Under A Short Zone
+const MaxNameLength = 200
+
+// Record Types Supported By The Module
+const (
+	RecordTypeA     = "A"
+	RecordTypeAAAA  = "AAAA"
+	RecordTypeTXT   = "TXT"
+	RecordTypeCNAME = "CNAME"
+
+	// Designates The Account Coins Sent To The Name Are Paid To, Instead Of Its Owner
+	RecordTypeADDR  = "ADDR"
+
+	// TTL (Seconds) Used When A Record Doesn't Set One
+	DefaultRecordTTL uint32 = 300
+)
+
 type WhoIs struct {
 	Value string			`json:"value"`
 	Owner sdk.AccAddress 	`json:"owner"`
 	Price sdk.Coins			`json:"price"`
+	Records []Record		`json:"records"`
+
+	// Set On Names With Several Owners, Owner Being The First Of Them
+	Owners []sdk.AccAddress	`json:"owners,omitempty"`
+	Threshold uint32		`json:"threshold,omitempty"`
+
+	// Set On Names Under Harberger Tax, Price Being The Owner's Own Assessment
+	SelfAssessed bool		`json:"self_assessed,omitempty"`
+	Deposit sdk.Coins		`json:"deposit,omitempty"`
+	TaxPaidThrough int64	`json:"tax_paid_through,omitempty"`
+
+	// What Registering The Name Cost, Held By The Module Account Until It Is Deleted Or Sold
+	Escrow sdk.Coins		`json:"escrow,omitempty"`
+}
+
+// Typed Record Attached To A Name
+type Record struct {
+	Type string				`json:"type"`
+	Value string			`json:"value"`
+	TTL uint32				`json:"ttl"`
 }
 
 // whoIs Constructor
@@ -23,7 +66,129 @@ func NewWhoIs() WhoIs {
 	}
 }
 
+// Record Constructor
+func NewRecord(recordType string, value string, ttl uint32) Record {
+	return Record {
+		Type: strings.ToUpper(recordType),
+		Value: value,
+		TTL: ttl,
+	}
+}
+
+// RecordFromValue Derives The Record Served For A Name Without Typed Records
+func RecordFromValue(value string) Record {
+	ip := net.ParseIP(value)
+
+	switch {
+	case ip != nil && ip.To4() != nil:
+		return NewRecord(RecordTypeA, value, DefaultRecordTTL)
+	case ip != nil:
+		return NewRecord(RecordTypeAAAA, value, DefaultRecordTTL)
+	default:
+		return NewRecord(RecordTypeTXT, value, DefaultRecordTTL)
+	}
+}
+
+// PaymentAddress Returns The Account Designated By The First ADDR Record, Or The Owner
+func (w WhoIs) PaymentAddress() sdk.AccAddress {
+	for _, record := range w.Records {
+		if record.Type != RecordTypeADDR {
+			continue
+		}
+
+		// Records Are Validated On The Way In, This Only Guards Against Bad Genesis Data
+		if addr, err := sdk.AccAddressFromBech32(record.Value); err == nil {
+			return addr
+		}
+	}
+
+	return w.Owner
+}
+
+// IsMultiOwner Reports Whether The Name Is Held By A Set Of Owners And A Threshold
+func (w WhoIs) IsMultiOwner() bool {
+	return len(w.Owners) > 0
+}
+
+// AllOwners Returns The Name's Owners, Its Single Owner Included
+func (w WhoIs) AllOwners() []sdk.AccAddress {
+	if w.IsMultiOwner() {
+		return w.Owners
+	}
+
+	if w.Owner.Empty() {
+		return nil
+	}
+	return []sdk.AccAddress{w.Owner}
+}
+
+// IsOwner Reports Whether addr Is The Name's Owner Or One Of Them
+func (w WhoIs) IsOwner(addr sdk.AccAddress) bool {
+	for _, owner := range w.AllOwners() {
+		if owner.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 // whoIs Print Function
 func (w WhoIs) String() string {
-	return strings.TrimSpace(fmt.Sprintf(`Owner: %s\n Value: %s\n Price: %s`, w.Owner, w.Value, w.Price))
+	return strings.TrimSpace(fmt.Sprintf(`Owner: %s\n Value: %s\n Price: %s\n Records: %d`, w.Owner, w.Value, w.Price, len(w.Records)))
+}
+
+// Record Print Function
+func (r Record) String() string {
+	return fmt.Sprintf("%s\t%d\t%s", r.Type, r.TTL, r.Value)
+}
+
+// ValidateName Checks A Name Against The Module's Naming Rules
+func ValidateName(name string) error {
+	if len(name) == 0 {
+		return sdkerrors.Wrap(ErrInvalidName, "Name cannot be empty")
+	}
+
+	if len(name) > MaxNameLength {
+		return sdkerrors.Wrapf(ErrInvalidName, "%s is longer than %d characters", name, MaxNameLength)
+	}
+
+	if !nameRegexp.MatchString(name) {
+		return sdkerrors.Wrapf(ErrInvalidName, "%s must be lower-case letters, digits and hyphens, in dot separated labels", name)
+	}
+
+	return nil
+}
+
+// Record Stateless Checks
+func (r Record) Validate() error {
+	if r.TTL == 0 {
+		return sdkerrors.Wrap(ErrInvalidRecord, "TTL must be positive")
+	}
+
+	switch r.Type {
+	case RecordTypeA:
+		if ip := net.ParseIP(r.Value); ip == nil || ip.To4() == nil {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "%s is not an IPv4 address", r.Value)
+		}
+	case RecordTypeAAAA:
+		if ip := net.ParseIP(r.Value); ip == nil || ip.To4() != nil {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "%s is not an IPv6 address", r.Value)
+		}
+	case RecordTypeCNAME:
+		if len(r.Value) == 0 || len(r.Value) > 253 || strings.ContainsAny(r.Value, " \t") {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "%s is not a valid host name", r.Value)
+		}
+	case RecordTypeTXT:
+		if len(r.Value) > 255 {
+			return sdkerrors.Wrap(ErrInvalidRecord, "TXT value longer than 255 bytes")
+		}
+	case RecordTypeADDR:
+		if _, err := sdk.AccAddressFromBech32(r.Value); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidRecord, "%s is not an account address", r.Value)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidRecord, "unsupported record type %s", r.Type)
+	}
+
+	return nil
 }